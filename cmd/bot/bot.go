@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/bot"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	endpoint   string
+	game       string
+	count      int
+	pace       time.Duration
+	skill      string
+	branch     string
+	ownBranch  bool
+	mergeEvery int
+}
+
+func NewBotCmd() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "bot",
+		Short: "Join a game server with bot players",
+		RunE:  opts.runE,
+	}
+
+	cmd.PersistentFlags().StringVarP(&opts.endpoint, "endpoint", "e", "http://localhost:9999/graphql", "The GraphQL endpoint of the game server.")
+	cmd.PersistentFlags().StringVarP(&opts.game, "game", "g", "default", "The ID of the game to play.")
+	cmd.PersistentFlags().IntVarP(&opts.count, "count", "n", 1, "The number of bots to spawn.")
+	cmd.PersistentFlags().DurationVar(&opts.pace, "pace", 3*time.Second, "The average time a bot takes for a move.")
+	cmd.PersistentFlags().StringVar(&opts.skill, "skill", "intermediate", "The skill level of the bots: novice, intermediate or expert.")
+	cmd.PersistentFlags().StringVarP(&opts.branch, "branch", "b", "master", "The branch the bots play on.")
+	cmd.PersistentFlags().BoolVar(&opts.ownBranch, "own-branch", false, "Let every bot work on its own branch and merge it back, through a proposal if the branch requires approval.")
+	cmd.PersistentFlags().IntVar(&opts.mergeEvery, "merge-every", 10, "The number of commits a bot makes on its own branch before merging it back.")
+
+	return cmd
+}
+
+func (o *options) runE(_ *cobra.Command, _ []string) error {
+	return bot.Run(bot.RunOptions{
+		Endpoint:   o.endpoint,
+		GameID:     o.game,
+		Count:      o.count,
+		Pace:       o.pace,
+		Skill:      o.skill,
+		Branch:     o.branch,
+		OwnBranch:  o.ownBranch,
+		MergeEvery: o.mergeEvery,
+	})
+}
@@ -2,11 +2,27 @@ package gameserver
 
 import (
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph"
 	"github.com/spf13/cobra"
 )
 
 type options struct {
-	port int
+	port    int
+	dataDir string
+
+	puzzle     string
+	puzzleFile string
+	puzzleDir  string
+	puzzleName string
+	difficulty string
+
+	tokenKey       string
+	trustedProxies []string
+
+	eventQueueSize     int
+	slowConsumerPolicy string
+
+	maxGames int
 }
 
 func NewGameServerCmd() *cobra.Command {
@@ -19,12 +35,40 @@ func NewGameServerCmd() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().IntVarP(&opts.port, "port", "p", 9999, "The serving port.")
+	cmd.PersistentFlags().StringVar(&opts.dataDir, "data-dir", "", "The folder to keep the game repositories in, in memory if not set.")
+	cmd.PersistentFlags().StringVar(&opts.puzzle, "puzzle", "", "The puzzle of a new game, as 81 digits with 0 or '.' for empty cells.")
+	cmd.PersistentFlags().StringVar(&opts.puzzleFile, "puzzle-file", "", "The file to read the puzzle of a new game from.")
+	cmd.PersistentFlags().StringVar(&opts.puzzleDir, "puzzle-dir", "", "The puzzle library folder, with 1 puzzle per .txt file.")
+	cmd.PersistentFlags().StringVar(&opts.puzzleName, "puzzle-name", "", "The name of the puzzle of a new game in the puzzle library.")
+	cmd.PersistentFlags().StringVar(&opts.difficulty, "difficulty", "medium", "The difficulty of the generated puzzle if no other puzzle is given: easy, medium, hard or expert.")
+
+	cmd.PersistentFlags().StringVar(&opts.tokenKey, "token-key", "", "The secret key signing the session tokens of the players, random if not set.")
+	cmd.PersistentFlags().StringSliceVar(&opts.trustedProxies, "trusted-proxies", nil, "The IP addresses and CIDR ranges of the proxies whose X-Forwarded-For header is honored.")
+
+	cmd.PersistentFlags().IntVar(&opts.eventQueueSize, "event-queue-size", 64, "The number of pending events of a subscriber before the slow consumer policy applies.")
+	cmd.PersistentFlags().StringVar(&opts.slowConsumerPolicy, "slow-consumer-policy", "drop-oldest", "What happens to a subscriber with a full queue: drop-oldest drops its oldest event, disconnect ends its subscription.")
+
+	cmd.PersistentFlags().IntVar(&opts.maxGames, "max-games", graph.DefaultMaxGames, "The number of games the server hosts at most, createGame is refused past it.")
 
 	return cmd
 }
 
 func (o *options) runE(_ *cobra.Command, _ []string) error {
 	return gameserver.Serve(gameserver.ServeOptions{
-		Port: o.port,
+		Port:       o.port,
+		DataDir:    o.dataDir,
+		Puzzle:     o.puzzle,
+		PuzzleFile: o.puzzleFile,
+		PuzzleDir:  o.puzzleDir,
+		PuzzleName: o.puzzleName,
+		Difficulty: o.difficulty,
+
+		TokenKey:       o.tokenKey,
+		TrustedProxies: o.trustedProxies,
+
+		EventQueueSize:     o.eventQueueSize,
+		SlowConsumerPolicy: o.slowConsumerPolicy,
+
+		MaxGames: o.maxGames,
 	})
 }
@@ -0,0 +1,53 @@
+package loadtest
+
+import (
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/loadtest"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	endpoint    string
+	game        string
+	players     int
+	duration    time.Duration
+	rampUp      time.Duration
+	rate        float64
+	branchRatio float64
+	mergeRatio  float64
+}
+
+func NewLoadTestCmd() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   "loadtest",
+		Short: "Simulate concurrent players against a game server and report latencies",
+		RunE:  opts.runE,
+	}
+
+	cmd.PersistentFlags().StringVarP(&opts.endpoint, "endpoint", "e", "http://localhost:9999/graphql", "The GraphQL endpoint of the game server.")
+	cmd.PersistentFlags().StringVarP(&opts.game, "game", "g", "default", "The ID of the game to play.")
+	cmd.PersistentFlags().IntVarP(&opts.players, "players", "n", 10, "The number of simulated players.")
+	cmd.PersistentFlags().DurationVarP(&opts.duration, "duration", "d", time.Minute, "How long the test runs after all players joined.")
+	cmd.PersistentFlags().DurationVar(&opts.rampUp, "ramp-up", 5*time.Second, "The time over which the players join.")
+	cmd.PersistentFlags().Float64VarP(&opts.rate, "rate", "r", 1, "The number of operations per second of every player.")
+	cmd.PersistentFlags().Float64Var(&opts.branchRatio, "branch-ratio", 0.05, "The share of operations creating a branch.")
+	cmd.PersistentFlags().Float64Var(&opts.mergeRatio, "merge-ratio", 0.05, "The share of operations merging master into the branch of a player.")
+
+	return cmd
+}
+
+func (o *options) runE(_ *cobra.Command, _ []string) error {
+	return loadtest.Run(loadtest.RunOptions{
+		Endpoint:    o.endpoint,
+		GameID:      o.game,
+		Players:     o.players,
+		Duration:    o.duration,
+		RampUp:      o.rampUp,
+		Rate:        o.rate,
+		BranchRatio: o.branchRatio,
+		MergeRatio:  o.mergeRatio,
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/nhan-ng/sudoku/cmd/bot"
 	"github.com/nhan-ng/sudoku/cmd/coordinator"
 	"github.com/nhan-ng/sudoku/cmd/gameserver"
+	"github.com/nhan-ng/sudoku/cmd/loadtest"
 
 	"github.com/nhan-ng/sudoku/cmd/play"
 
@@ -30,6 +32,8 @@ func init() {
 	rootCmd.AddCommand(play.NewPlayCmd())
 	rootCmd.AddCommand(gameserver.NewGameServerCmd())
 	rootCmd.AddCommand(coordinator.NewCoordinatorCmd())
+	rootCmd.AddCommand(bot.NewBotCmd())
+	rootCmd.AddCommand(loadtest.NewLoadTestCmd())
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
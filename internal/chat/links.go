@@ -0,0 +1,83 @@
+package chat
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type LinkType string
+
+const (
+	LinkTypeCell   LinkType = "CELL"
+	LinkTypeCommit LinkType = "COMMIT"
+)
+
+// minCommitIDLength is the shortest abbreviated commit ID recognized in a message, as git abbreviates them by default
+const minCommitIDLength = 7
+
+var (
+	cellPattern   = regexp.MustCompile(`(?i)\br([1-9])c([1-9])\b`)
+	commitPattern = regexp.MustCompile(`\b[0-9a-f]{7,40}\b`)
+)
+
+// Link is a reference to a cell or a commit found in a message. Start and End are the byte offsets of the
+// reference in the message, Row and Col are 0-based.
+type Link struct {
+	Type  LinkType
+	Text  string
+	Start int
+	End   int
+
+	Row      int
+	Col      int
+	CommitID string
+}
+
+// CommitResolver returns the full ID of the commit with the given ID or ID prefix, if it exists.
+type CommitResolver func(prefix string) (string, bool)
+
+// ParseLinks finds the cell references, like r3c5, and the commit IDs in the text. Hexadecimal words
+// are only links if resolveCommit knows them.
+func ParseLinks(text string, resolveCommit CommitResolver) []Link {
+	links := make([]Link, 0)
+	for _, match := range cellPattern.FindAllStringSubmatchIndex(text, -1) {
+		row, _ := strconv.Atoi(text[match[2]:match[3]])
+		col, _ := strconv.Atoi(text[match[4]:match[5]])
+		links = append(links, Link{
+			Type:  LinkTypeCell,
+			Text:  text[match[0]:match[1]],
+			Start: match[0],
+			End:   match[1],
+			Row:   row - 1,
+			Col:   col - 1,
+		})
+	}
+
+	if resolveCommit != nil {
+		for _, match := range commitPattern.FindAllStringIndex(text, -1) {
+			prefix := strings.ToLower(text[match[0]:match[1]])
+			if len(prefix) < minCommitIDLength {
+				continue
+			}
+			commitID, ok := resolveCommit(prefix)
+			if !ok {
+				continue
+			}
+			links = append(links, Link{
+				Type:     LinkTypeCommit,
+				Text:     text[match[0]:match[1]],
+				Start:    match[0],
+				End:      match[1],
+				CommitID: commitID,
+			})
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].Start < links[j].Start
+	})
+
+	return links
+}
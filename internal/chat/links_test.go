@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const commitID = "3f7c2a9e5d1b4c6a8f0e2d4b6a8c0e2f4a6b8c0d"
+
+func resolveCommit(prefix string) (string, bool) {
+	if strings.HasPrefix(commitID, prefix) {
+		return commitID, true
+	}
+	return "", false
+}
+
+func TestParseLinks_CellReferences_ReturnExpected(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	text := "R3c5 can only be a 7, check r9C1 too"
+
+	// Act
+	got := ParseLinks(text, nil)
+
+	// Assert
+	r.Equal([]Link{
+		{Type: LinkTypeCell, Text: "R3c5", Start: 0, End: 4, Row: 2, Col: 4},
+		{Type: LinkTypeCell, Text: "r9C1", Start: 28, End: 32, Row: 8, Col: 0},
+	}, got, "got")
+}
+
+func TestParseLinks_OutOfBoardCell_ReturnEmpty(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	text := "r0c5, r10c1 and xr3c5 are not cells"
+
+	// Act
+	got := ParseLinks(text, nil)
+
+	// Assert
+	r.Empty(got, "got")
+}
+
+func TestParseLinks_CommitIDs_ReturnKnownCommits(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	text := "reverted 3f7c2a9 but deadbeef00 is unknown, see r1c1"
+
+	// Act
+	got := ParseLinks(text, resolveCommit)
+
+	// Assert
+	r.Equal([]Link{
+		{Type: LinkTypeCommit, Text: "3f7c2a9", Start: 9, End: 16, CommitID: commitID},
+		{Type: LinkTypeCell, Text: "r1c1", Start: 48, End: 52, Row: 0, Col: 0},
+	}, got, "got")
+}
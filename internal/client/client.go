@@ -0,0 +1,185 @@
+//go:generate go run ./clientgen -schema ../cmd/gameserver/graph/*.graphql
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// bearerPrefix prefixes the session token in the Authorization header.
+const bearerPrefix = "Bearer "
+
+// DefaultGameID is the game started along with the gameserver.
+const DefaultGameID = "default"
+
+type Options struct {
+	// Endpoint is the GraphQL endpoint of the gameserver, e.g. http://localhost:9999/graphql
+	Endpoint string
+
+	// Token resumes the session of a player who already joined, otherwise it is set by Client.Join
+	Token string
+
+	// GameID is the game the client plays, DefaultGameID if not set
+	GameID string
+
+	// HTTPClient is used for queries and mutations, http.DefaultClient if not set
+	HTTPClient *http.Client
+}
+
+// Client is a typed client of the gameserver GraphQL API.
+type Client struct {
+	endpoint   string
+	gameID     string
+	httpClient *http.Client
+
+	mu    sync.RWMutex
+	token string
+}
+
+type request struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors Errors          `json:"errors"`
+}
+
+// Error is a single error returned by the GraphQL API.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Errors is the list of errors returned by the GraphQL API for a single operation.
+type Errors []*Error
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+
+	return fmt.Sprintf("graphql: %s", strings.Join(messages, "; "))
+}
+
+func New(opts Options) *Client {
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	gameID := opts.GameID
+	if gameID == "" {
+		gameID = DefaultGameID
+	}
+
+	return &Client{
+		endpoint:   opts.Endpoint,
+		token:      opts.Token,
+		gameID:     gameID,
+		httpClient: httpClient,
+	}
+}
+
+// Token returns the session token the client identifies its player by.
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.token
+}
+
+func (c *Client) setToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = token
+}
+
+// GameID returns the game the client plays.
+func (c *Client) GameID() string {
+	return c.gameID
+}
+
+// Join joins the game as a new player, or as the player of the client's session if it has one.
+// The client identifies as that player afterwards.
+func (c *Client) Join(ctx context.Context) (*Player, error) {
+	payload, err := c.join(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, nil
+	}
+	c.setToken(payload.Token)
+
+	return payload.Player, nil
+}
+
+// Do executes a raw GraphQL operation and decodes its data into result.
+func (c *Client) Do(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	body, err := json.Marshal(request{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	c.setAuthorization(req.Header)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var res response
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return fmt.Errorf("failed to decode response with status %d: %w", resp.StatusCode, err)
+	}
+	if len(res.Errors) > 0 {
+		return res.Errors
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+	err = json.Unmarshal(res.Data, result)
+	if err != nil {
+		return fmt.Errorf("failed to decode response data: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) authorization() string {
+	token := c.Token()
+	if token == "" {
+		return ""
+	}
+
+	return bearerPrefix + token
+}
+
+func (c *Client) setAuthorization(header http.Header) {
+	if authorization := c.authorization(); authorization != "" {
+		header.Set("Authorization", authorization)
+	}
+}
@@ -0,0 +1,278 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// graphqlServer answers every request with the response, after recording the request.
+func graphqlServer(t *testing.T, response string, requests chan<- *http.Request, bodies chan<- request) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body request
+		err := json.NewDecoder(req.Body).Decode(&body)
+		if err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		requests <- req
+		bodies <- body
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+}
+
+func TestClient_Query_SendGameAndDecodeResult(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	requests, bodies := make(chan *http.Request, 1), make(chan request, 1)
+	server := graphqlServer(t, `{"data":{"sudoku":{"branchId":"master","board":[[1,0],[0,2]],"puzzle":{"name":"easy-1","source":"LIBRARY","difficulty":"EASY","givens":2}}}}`, requests, bodies)
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL, GameID: "game", Token: "token"})
+
+	// Act
+	sudoku, err := c.Sudoku(context.Background())
+
+	// Assert
+	r.NoError(err, "err")
+	r.Equal("master", sudoku.BranchID, "branch")
+	r.Equal([][]int{{1, 0}, {0, 2}}, sudoku.Board, "board")
+	r.Equal(model.DifficultyEasy, sudoku.Puzzle.Difficulty, "difficulty")
+	req, body := <-requests, <-bodies
+	r.Equal(http.MethodPost, req.Method, "method")
+	r.Equal("Bearer token", req.Header.Get("Authorization"), "authorization")
+	r.Contains(body.Query, "query Sudoku", "query")
+	r.Contains(body.Query, "fragment BranchFields on Branch", "fragment")
+	r.Equal(map[string]interface{}{"gameId": "game"}, body.Variables, "variables")
+}
+
+func TestClient_QueryWithoutOptionals_LeaveOutVariables(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	requests, bodies := make(chan *http.Request, 1), make(chan request, 1)
+	server := graphqlServer(t, `{"data":{"messages":[]}}`, requests, bodies)
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL})
+
+	// Act
+	messages, err := c.Messages(context.Background(), nil, nil)
+
+	// Assert
+	r.NoError(err, "err")
+	r.Empty(messages, "messages")
+	<-requests
+	r.Equal(map[string]interface{}{"gameId": DefaultGameID}, (<-bodies).Variables, "variables")
+}
+
+func TestClient_Mutation_DefaultInputGame(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	requests, bodies := make(chan *http.Request, 1), make(chan request, 1)
+	server := graphqlServer(t, `{"data":{"addCommit":{"commit":{"id":"abc","type":"ADD_FILL","row":1,"col":2,"val":3}}}}`, requests, bodies)
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL, GameID: "game"})
+	val := 3
+
+	// Act
+	payload, err := c.AddCommit(context.Background(), model.AddCommitInput{
+		BranchID: "master",
+		Type:     model.CommitTypeAddFill,
+		Row:      1,
+		Col:      2,
+		Val:      &val,
+	})
+
+	// Assert
+	r.NoError(err, "err")
+	r.Equal("abc", payload.Commit.ID, "commit")
+	r.Equal(model.CommitTypeAddFill, payload.Commit.Type, "type")
+	req, body := <-requests, <-bodies
+	r.Empty(req.Header.Get("Authorization"), "authorization")
+	r.Contains(body.Query, "mutation AddCommit", "query")
+	input := body.Variables["input"].(map[string]interface{})
+	r.Equal("game", input["gameId"], "gameId")
+	r.Equal("master", input["branchId"], "branchId")
+}
+
+func TestClient_Join_KeepToken(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	requests, bodies := make(chan *http.Request, 2), make(chan request, 2)
+	server := graphqlServer(t, `{"data":{"join":{"player":{"id":"p1","displayName":"Player One","color":"#123456"},"token":"token"},"leave":{"player":{"id":"p1"}}}}`, requests, bodies)
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL})
+
+	// Act
+	player, err := c.Join(context.Background())
+	r.NoError(err, "join")
+	_, err = c.Leave(context.Background())
+
+	// Assert
+	r.NoError(err, "leave")
+	r.Equal("p1", player.ID, "player")
+	r.Equal("token", c.Token(), "token")
+	r.Empty((<-requests).Header.Get("Authorization"), "join authorization")
+	r.Equal("Bearer token", (<-requests).Header.Get("Authorization"), "leave authorization")
+}
+
+func TestClient_Query_ReturnErrors(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	requests, bodies := make(chan *http.Request, 1), make(chan request, 1)
+	server := graphqlServer(t, `{"data":null,"errors":[{"message":"branch not found","path":["branch"]}]}`, requests, bodies)
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL})
+
+	// Act
+	branch, err := c.Branch(context.Background(), "missing")
+
+	// Assert
+	r.Nil(branch, "branch")
+	errs, ok := err.(Errors)
+	r.True(ok, "errors")
+	r.Equal("branch not found", errs[0].Message, "message")
+	r.Equal("missing", (<-bodies).Variables["id"], "id")
+}
+
+// websocketServer runs the handler on every websocket connection speaking graphql-ws.
+func websocketServer(t *testing.T, handler func(conn *websocket.Conn)) *httptest.Server {
+	upgrader := websocket.Upgrader{Subprotocols: []string{"graphql-ws"}}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		conn, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade: %v", err)
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+}
+
+func TestClient_Subscription_HandshakeAndReceive(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	received := make(chan wsMessage, 2)
+	server := websocketServer(t, func(conn *websocket.Conn) {
+		var init, start wsMessage
+		_ = conn.ReadJSON(&init)
+		received <- init
+		_ = conn.WriteJSON(wsMessage{Type: wsConnectionKeepAlive})
+		_ = conn.WriteJSON(wsMessage{Type: wsConnectionAck})
+		_ = conn.ReadJSON(&start)
+		received <- start
+		_ = conn.WriteJSON(wsMessage{ID: subscriptionID, Type: wsData, Payload: json.RawMessage(`{"data":{"commitAdded":{"id":"abc","type":"ADD_FILL"}}}`)})
+		_ = conn.WriteJSON(wsMessage{ID: subscriptionID, Type: wsComplete})
+
+		// Wait for the client to stop
+		var msg wsMessage
+		for conn.ReadJSON(&msg) == nil {
+		}
+	})
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL, GameID: "game", Token: "token"})
+
+	// Act
+	sub, commits, err := c.CommitAdded(context.Background(), "master", nil)
+	r.NoError(err, "subscribe")
+	commit := <-commits
+	_, open := <-commits
+
+	// Assert
+	r.Equal("abc", commit.ID, "commit")
+	r.False(open, "open")
+	r.Equal(ErrResyncRequired, sub.Err(), "err")
+	init := <-received
+	r.Equal(wsConnectionInit, init.Type, "init")
+	r.JSONEq(`{"Authorization":"Bearer token"}`, string(init.Payload), "init payload")
+	start := <-received
+	r.Equal(wsStart, start.Type, "start")
+	var body request
+	r.NoError(json.Unmarshal(start.Payload, &body), "start payload")
+	r.True(strings.HasPrefix(strings.TrimSpace(body.Query), "subscription CommitAdded"), "query")
+	r.Equal(map[string]interface{}{"gameId": "game", "branchId": "master"}, body.Variables, "variables")
+}
+
+func TestClient_SubscriptionRejected_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	server := websocketServer(t, func(conn *websocket.Conn) {
+		var init wsMessage
+		_ = conn.ReadJSON(&init)
+		_ = conn.WriteJSON(wsMessage{Type: wsConnectionError, Payload: json.RawMessage(`{"message":"invalid token"}`)})
+	})
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL})
+
+	// Act
+	_, _, err := c.PlayersChanged(context.Background())
+
+	// Assert
+	r.Error(err, "err")
+	r.Contains(err.Error(), "invalid token", "err")
+}
+
+func TestClient_SubscriptionWithoutAck_TimeOut(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	server := websocketServer(t, func(conn *websocket.Conn) {
+		var msg wsMessage
+		for conn.ReadJSON(&msg) == nil {
+		}
+	})
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Act
+	start := time.Now()
+	_, _, err := c.BranchEvents(ctx)
+
+	// Assert
+	r.Error(err, "err")
+	r.Less(int64(time.Since(start)), int64(handshakeTimeout), "elapsed")
+}
+
+func TestClient_SubscriptionContextDone_CloseSubscription(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	server := websocketServer(t, func(conn *websocket.Conn) {
+		var msg wsMessage
+		_ = conn.ReadJSON(&msg)
+		_ = conn.WriteJSON(wsMessage{Type: wsConnectionAck})
+		for conn.ReadJSON(&msg) == nil {
+		}
+	})
+	defer server.Close()
+	c := New(Options{Endpoint: server.URL})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Act
+	sub, events, err := c.ProposalEvents(ctx)
+	r.NoError(err, "subscribe")
+	cancel()
+
+	// Assert
+	_, open := <-events
+	r.False(open, "open")
+	<-sub.Done()
+	r.NoError(sub.Err(), "err")
+}
@@ -0,0 +1,448 @@
+// Command clientgen generates the typed client of the gameserver from the operations of the client, validated against
+// the schema of the gameserver.
+//
+// Each operation becomes a method of Client named as the operation, taking its variables and returning its single
+// root field. The gameId variable and the gameId field of inputs default to the game of the client. Enums and inputs
+// are the types of the model package, each object type becomes a struct with every field selected by any operation,
+// so a field not selected by an operation is left unset. Every root field of the schema must be used by an operation,
+// so that the client doesn't miss any part of the API.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+)
+
+const modelPackage = "github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+
+// gameIDVariable is both the variable and the input field defaulting to the game of the client
+const gameIDVariable = "gameId"
+
+var scalars = map[string]string{
+	"ID":      "string",
+	"String":  "string",
+	"Int":     "int",
+	"Float":   "float64",
+	"Boolean": "bool",
+	"Time":    "time.Time",
+}
+
+var initialisms = map[string]string{
+	"Id":   "ID",
+	"Ids":  "IDs",
+	"Url":  "URL",
+	"Json": "JSON",
+}
+
+func main() {
+	schemaGlob := flag.String("schema", "", "glob of the schema files of the gameserver")
+	operations := flag.String("operations", "operations.graphql", "file of the operations of the client")
+	out := flag.String("out", "generated.go", "file of the generated client")
+	pkg := flag.String("package", "client", "package of the generated client")
+	flag.Parse()
+
+	err := run(*schemaGlob, *operations, *out, *pkg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "clientgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaGlob, operations, out, pkg string) error {
+	files, err := filepath.Glob(schemaGlob)
+	if err != nil {
+		return fmt.Errorf("invalid schema glob: %w", err)
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no schema file matches %s", schemaGlob)
+	}
+	sources := make([]*ast.Source, 0, len(files))
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read schema: %w", err)
+		}
+		sources = append(sources, &ast.Source{Name: file, Input: string(content)})
+	}
+	schema, gqlErr := gqlparser.LoadSchema(sources...)
+	if gqlErr != nil {
+		return gqlErr
+	}
+
+	content, err := ioutil.ReadFile(operations)
+	if err != nil {
+		return fmt.Errorf("failed to read operations: %w", err)
+	}
+	doc, gqlErrs := gqlparser.LoadQuery(schema, string(content))
+	if len(gqlErrs) > 0 {
+		return fmt.Errorf("invalid operations: %w", gqlErrs)
+	}
+
+	g := &generator{
+		schema:   schema,
+		doc:      doc,
+		selected: make(map[string]map[string]bool),
+		imports:  map[string]bool{"context": true},
+	}
+	src, err := g.generate(pkg)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(out, src, 0644)
+}
+
+type generator struct {
+	schema *ast.Schema
+	doc    *ast.QueryDocument
+
+	// selected are the fields selected by any operation, by object type
+	selected map[string]map[string]bool
+	imports  map[string]bool
+}
+
+func (g *generator) generate(pkg string) ([]byte, error) {
+	err := g.checkCoverage()
+	if err != nil {
+		return nil, err
+	}
+
+	var operations bytes.Buffer
+	for _, op := range g.doc.Operations {
+		err := g.writeOperation(&operations, op)
+		if err != nil {
+			return nil, fmt.Errorf("operation %s: %w", op.Name, err)
+		}
+	}
+
+	var types bytes.Buffer
+	typeNames := make([]string, 0, len(g.selected))
+	for typeName := range g.selected {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		g.writeType(&types, g.schema.Types[typeName])
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by clientgen, DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		imports = append(imports, imp)
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		// Standard packages first
+		if std := !strings.Contains(imports[i], "."); std != !strings.Contains(imports[j], ".") {
+			return std
+		}
+		return imports[i] < imports[j]
+	})
+	for i, imp := range imports {
+		if i > 0 && strings.Contains(imp, ".") && !strings.Contains(imports[i-1], ".") {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "%q\n", imp)
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(types.Bytes())
+	buf.Write(operations.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the generated client: %w", err)
+	}
+
+	return src, nil
+}
+
+// checkCoverage checks that every root field of the schema is used by an operation.
+func (g *generator) checkCoverage() error {
+	used := make(map[string]bool)
+	for _, op := range g.doc.Operations {
+		for _, sel := range op.SelectionSet {
+			if field, ok := sel.(*ast.Field); ok {
+				used[string(op.Operation)+"."+field.Name] = true
+			}
+		}
+	}
+
+	var missing []string
+	roots := map[ast.Operation]*ast.Definition{
+		ast.Query:        g.schema.Query,
+		ast.Mutation:     g.schema.Mutation,
+		ast.Subscription: g.schema.Subscription,
+	}
+	for operation, def := range roots {
+		if def == nil {
+			continue
+		}
+		for _, field := range def.Fields {
+			if !strings.HasPrefix(field.Name, "__") && !used[string(operation)+"."+field.Name] {
+				missing = append(missing, string(operation)+" "+field.Name)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("no operation for %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func (g *generator) writeOperation(w *bytes.Buffer, op *ast.OperationDefinition) error {
+	if op.Name == "" {
+		return fmt.Errorf("anonymous operations are not supported")
+	}
+	if len(op.SelectionSet) != 1 {
+		return fmt.Errorf("exactly one root field must be selected")
+	}
+	root, ok := op.SelectionSet[0].(*ast.Field)
+	if !ok {
+		return fmt.Errorf("the root field must be selected without fragment")
+	}
+	err := g.selectField(root)
+	if err != nil {
+		return err
+	}
+
+	// Parameters and variables
+	params := []string{"ctx context.Context"}
+	var variables, optionals, defaults bytes.Buffer
+	for _, v := range op.VariableDefinitions {
+		if v.Variable == gameIDVariable {
+			fmt.Fprintf(&variables, "%q: c.gameID,\n", v.Variable)
+			continue
+		}
+
+		param := goName(v.Variable, false)
+		if token.IsKeyword(param) {
+			return fmt.Errorf("variable %s is a Go keyword", v.Variable)
+		}
+		def := g.schema.Types[v.Type.Name()]
+		if v.Type.Elem == nil && def.Kind == ast.InputObject && v.Type.NonNull && def.Fields.ForName(gameIDVariable) != nil {
+			fmt.Fprintf(&defaults, "if %[1]s.%[2]s == \"\" {\n%[1]s.%[2]s = c.gameID\n}\n", param, goName(gameIDVariable, true))
+		}
+		params = append(params, param+" "+g.goType(v.Type))
+		if v.Type.NonNull {
+			fmt.Fprintf(&variables, "%q: %s,\n", v.Variable, param)
+		} else {
+			// Left out rather than null, so that the server applies the default value of the argument
+			fmt.Fprintf(&optionals, "if %[2]s != nil {\nvariables[%[1]q] = %[2]s\n}\n", v.Variable, param)
+		}
+	}
+	variablesArg := "nil"
+	if variables.Len() > 0 || optionals.Len() > 0 {
+		variablesArg = "variables"
+	}
+
+	// Document of the operation with the fragments it uses
+	constName := goName(op.Name, false) + "Operation"
+	query := &ast.QueryDocument{Operations: ast.OperationList{op}}
+	for _, name := range g.fragments(op.SelectionSet, nil) {
+		query.Fragments = append(query.Fragments, g.doc.Fragments.ForName(name))
+	}
+	var text bytes.Buffer
+	formatter.NewFormatter(&text).FormatQueryDocument(query)
+	fmt.Fprintf(w, "const %s = `\n%s`\n\n", constName, text.String())
+
+	resultType := g.goType(root.Definition.Type)
+	resultField := goName(root.Alias, true)
+	method := goName(op.Name, true)
+	if unicode.IsLower(rune(op.Name[0])) {
+		method = op.Name
+	}
+	if op.Operation == ast.Subscription {
+		g.imports["encoding/json"] = true
+		g.imports["fmt"] = true
+		fmt.Fprintf(w, "// %s runs the %s subscription. The returned channel is closed once the subscription ends, either by ctx, by Subscription.Close or by the server.\n", method, op.Name)
+		fmt.Fprintf(w, "func (c *Client) %s(%s) (*Subscription, <-chan %s, error) {\n", method, strings.Join(params, ", "), resultType)
+	} else {
+		fmt.Fprintf(w, "// %s runs the %s %s.\n", method, op.Name, op.Operation)
+		fmt.Fprintf(w, "func (c *Client) %s(%s) (%s, error) {\n", method, strings.Join(params, ", "), resultType)
+	}
+	w.Write(defaults.Bytes())
+	if variablesArg != "nil" {
+		fmt.Fprintf(w, "variables := map[string]interface{}{\n%s}\n", variables.String())
+		w.Write(optionals.Bytes())
+	}
+	if defaults.Len() > 0 || variablesArg != "nil" {
+		w.WriteString("\n")
+	}
+
+	if op.Operation == ast.Subscription {
+		fmt.Fprintf(w, "results := make(chan %s)\n", resultType)
+		fmt.Fprintf(w, "sub, err := c.subscribe(ctx, %s, %s, func(data json.RawMessage, done <-chan struct{}) error {\n", constName, variablesArg)
+		fmt.Fprintf(w, "var result struct {\n%s %s `json:%q`\n}\n", resultField, resultType, root.Alias)
+		fmt.Fprintf(w, "err := json.Unmarshal(data, &result)\nif err != nil {\nreturn fmt.Errorf(\"failed to decode %s: %%w\", err)\n}\n\n", root.Alias)
+		fmt.Fprintf(w, "select {\ncase results <- result.%s:\ncase <-done:\n}\nreturn nil\n", resultField)
+		w.WriteString("}, func() {\nclose(results)\n})\nif err != nil {\nreturn nil, nil, err\n}\n\nreturn sub, results, nil\n}\n\n")
+		return nil
+	}
+
+	fmt.Fprintf(w, "var data struct {\n%s %s `json:%q`\n}\n", resultField, resultType, root.Alias)
+	fmt.Fprintf(w, "err := c.Do(ctx, %s, %s, &data)\n\nreturn data.%s, err\n}\n\n", constName, variablesArg, resultField)
+
+	return nil
+}
+
+// selectField records the fields selected on the type of the field.
+func (g *generator) selectField(field *ast.Field) error {
+	if field.Alias != field.Name {
+		return fmt.Errorf("alias %s of %s is not supported", field.Alias, field.Name)
+	}
+
+	def := g.schema.Types[field.Definition.Type.Name()]
+	switch def.Kind {
+	case ast.Object:
+		return g.selectFields(def, field.SelectionSet)
+	case ast.Scalar:
+		if _, ok := scalars[def.Name]; !ok {
+			return fmt.Errorf("scalar %s is not supported", def.Name)
+		}
+	case ast.Enum:
+	default:
+		return fmt.Errorf("%s %s is not supported", strings.ToLower(string(def.Kind)), def.Name)
+	}
+
+	return nil
+}
+
+func (g *generator) selectFields(def *ast.Definition, selectionSet ast.SelectionSet) error {
+	if g.selected[def.Name] == nil {
+		g.selected[def.Name] = make(map[string]bool)
+	}
+
+	for _, sel := range selectionSet {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				return fmt.Errorf("field %s is not supported", sel.Name)
+			}
+			g.selected[def.Name][sel.Name] = true
+			err := g.selectField(sel)
+			if err != nil {
+				return err
+			}
+		case *ast.FragmentSpread:
+			err := g.selectFields(def, sel.Definition.SelectionSet)
+			if err != nil {
+				return err
+			}
+		case *ast.InlineFragment:
+			err := g.selectFields(def, sel.SelectionSet)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// fragments returns the names of the fragments spread in the selection set, in order of first use.
+func (g *generator) fragments(selectionSet ast.SelectionSet, names []string) []string {
+	for _, sel := range selectionSet {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			names = g.fragments(sel.SelectionSet, names)
+		case *ast.FragmentSpread:
+			if !contains(names, sel.Name) {
+				names = append(names, sel.Name)
+				names = g.fragments(sel.Definition.SelectionSet, names)
+			}
+		case *ast.InlineFragment:
+			names = g.fragments(sel.SelectionSet, names)
+		}
+	}
+
+	return names
+}
+
+func (g *generator) writeType(w *bytes.Buffer, def *ast.Definition) {
+	fmt.Fprintf(w, "type %s struct {\n", def.Name)
+	for _, field := range def.Fields {
+		if g.selected[def.Name][field.Name] {
+			fmt.Fprintf(w, "%s %s `json:%q`\n", goName(field.Name, true), g.goType(field.Type), field.Name)
+		}
+	}
+	w.WriteString("}\n\n")
+}
+
+// goType returns the Go type of a GraphQL type, objects are always pointers and other nullable types too.
+func (g *generator) goType(t *ast.Type) string {
+	if t.Elem != nil {
+		return "[]" + g.goType(t.Elem)
+	}
+
+	def := g.schema.Types[t.NamedType]
+	name := def.Name
+	pointer := !t.NonNull
+	switch def.Kind {
+	case ast.Object:
+		pointer = true
+	case ast.Enum, ast.InputObject:
+		g.imports[modelPackage] = true
+		name = "model." + def.Name
+	case ast.Scalar:
+		name = scalars[def.Name]
+		if strings.HasPrefix(name, "time.") {
+			g.imports["time"] = true
+		}
+	}
+	if pointer {
+		return "*" + name
+	}
+
+	return name
+}
+
+// goName returns the Go name of a GraphQL name in camel case, with the initialisms in upper case.
+func goName(name string, exported bool) string {
+	var words []string
+	start := 0
+	for i := 1; i < len(name); i++ {
+		if unicode.IsUpper(rune(name[i])) && !unicode.IsUpper(rune(name[i-1])) {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	words = append(words, name[start:])
+
+	for i, word := range words {
+		word = strings.ToUpper(word[:1]) + word[1:]
+		if initialism, ok := initialisms[word]; ok {
+			word = initialism
+		}
+		if i == 0 && !exported {
+			word = strings.ToLower(word)
+		}
+		words[i] = word
+	}
+
+	return strings.Join(words, "")
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
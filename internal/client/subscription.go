@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Message types of the graphql-ws protocol spoken by the gameserver.
+const (
+	wsConnectionInit      = "connection_init"
+	wsConnectionAck       = "connection_ack"
+	wsConnectionError     = "connection_error"
+	wsConnectionKeepAlive = "ka"
+	wsConnectionTerminate = "connection_terminate"
+	wsStart               = "start"
+	wsStop                = "stop"
+	wsData                = "data"
+	wsError               = "error"
+	wsComplete            = "complete"
+)
+
+const subscriptionID = "1"
+
+// handshakeTimeout bounds the wait for the connection ack when ctx has no earlier deadline
+const handshakeTimeout = 10 * time.Second
+
+// ErrResyncRequired ends a subscription completed by the server, for falling behind or for the game closing.
+// Events may have been missed, the followed state has to be refetched before subscribing again.
+var ErrResyncRequired = errors.New("subscription completed by the server, resync required")
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// Subscription is a single GraphQL subscription running on its own websocket connection.
+type Subscription struct {
+	conn *websocket.Conn
+
+	closeOnce sync.Once
+	done      chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func (c *Client) subscribe(ctx context.Context, query string, variables map[string]interface{}, onData func(json.RawMessage, <-chan struct{}) error, onDone func()) (*Subscription, error) {
+	header := http.Header{}
+	c.setAuthorization(header)
+
+	dialer := websocket.Dialer{
+		Subprotocols: []string{"graphql-ws"},
+	}
+	conn, _, err := dialer.DialContext(ctx, websocketURL(c.endpoint), header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", c.endpoint, err)
+	}
+
+	sub := &Subscription{
+		conn: conn,
+		done: make(chan struct{}),
+	}
+
+	// Handshake
+	initPayload := map[string]string{}
+	if authorization := c.authorization(); authorization != "" {
+		initPayload["Authorization"] = authorization
+	}
+	initPayloadContent, err := json.Marshal(initPayload)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to marshal connection init payload: %w", err)
+	}
+	err = sub.write(wsMessage{Type: wsConnectionInit, Payload: initPayloadContent})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to initialize connection: %w", err)
+	}
+	deadline := time.Now().Add(handshakeTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	err = conn.SetReadDeadline(deadline)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set the handshake deadline: %w", err)
+	}
+	for {
+		var msg wsMessage
+		err = conn.ReadJSON(&msg)
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to read connection ack: %w", err)
+		}
+		if msg.Type == wsConnectionAck {
+			break
+		}
+		if msg.Type == wsConnectionError {
+			conn.Close()
+			return nil, fmt.Errorf("connection rejected: %s", msg.Payload)
+		}
+	}
+	err = conn.SetReadDeadline(time.Time{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to clear the handshake deadline: %w", err)
+	}
+
+	// Start the subscription
+	payload, err := json.Marshal(request{
+		Query:     query,
+		Variables: variables,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to marshal subscription: %w", err)
+	}
+	err = sub.write(wsMessage{ID: subscriptionID, Type: wsStart, Payload: payload})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to start subscription: %w", err)
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			sub.Close()
+		case <-sub.done:
+		}
+	}()
+	go func() {
+		defer onDone()
+		sub.setErr(sub.receive(onData))
+		sub.Close()
+	}()
+
+	return sub, nil
+}
+
+func (s *Subscription) receive(onData func(json.RawMessage, <-chan struct{}) error) error {
+	for {
+		var msg wsMessage
+		err := s.conn.ReadJSON(&msg)
+		if err != nil {
+			select {
+			case <-s.done:
+				return nil
+			default:
+				return fmt.Errorf("failed to read message: %w", err)
+			}
+		}
+
+		switch msg.Type {
+		case wsConnectionKeepAlive:
+
+		case wsData:
+			var res response
+			err = json.Unmarshal(msg.Payload, &res)
+			if err != nil {
+				return fmt.Errorf("failed to decode payload: %w", err)
+			}
+			if len(res.Errors) > 0 {
+				return res.Errors
+			}
+			err = onData(res.Data, s.done)
+			if err != nil {
+				return err
+			}
+
+		case wsError:
+			var errs Errors
+			err = json.Unmarshal(msg.Payload, &errs)
+			if err != nil {
+				return fmt.Errorf("subscription failed: %s", msg.Payload)
+			}
+			return errs
+
+		case wsComplete:
+			return ErrResyncRequired
+
+		case wsConnectionError:
+			return fmt.Errorf("connection failed: %s", msg.Payload)
+		}
+	}
+}
+
+// Err returns the error that ended the subscription, if any.
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Done is closed once the subscription is closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close stops the subscription and closes its connection.
+func (s *Subscription) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		_ = s.write(wsMessage{ID: subscriptionID, Type: wsStop})
+		_ = s.write(wsMessage{Type: wsConnectionTerminate})
+		err = s.conn.Close()
+	})
+	return err
+}
+
+func (s *Subscription) write(msg wsMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteJSON(msg)
+}
+
+func (s *Subscription) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+func websocketURL(endpoint string) string {
+	switch {
+	case strings.HasPrefix(endpoint, "https://"):
+		return "wss://" + strings.TrimPrefix(endpoint, "https://")
+	case strings.HasPrefix(endpoint, "http://"):
+		return "ws://" + strings.TrimPrefix(endpoint, "http://")
+	}
+	return endpoint
+}
@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/client"
+	"go.uber.org/zap"
+)
+
+type RunOptions struct {
+	Endpoint string
+	GameID   string
+	Count    int
+	Pace     time.Duration
+	Skill    string
+
+	// Branch is the branch the bots play on
+	Branch string
+
+	// OwnBranch lets every bot create a branch off Branch and merge it back every MergeEvery commits, or propose
+	// the merge if Branch requires approval like master
+	OwnBranch  bool
+	MergeEvery int
+}
+
+func Run(opts RunOptions) error {
+	skill, err := GetSkill(opts.Skill)
+	if err != nil {
+		return err
+	}
+	if opts.Count <= 0 {
+		return fmt.Errorf("invalid number of bots %d", opts.Count)
+	}
+
+	// Stop the bots on interrupt
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, opts.Count)
+	for i := 0; i < opts.Count; i++ {
+		p := &player{
+			client: client.New(client.Options{
+				Endpoint: opts.Endpoint,
+				GameID:   opts.GameID,
+			}),
+			skill:  skill,
+			opts:   opts,
+			rand:   rand.New(rand.NewSource(time.Now().UnixNano() + int64(i))),
+			logger: zap.L().With(zap.Int("bot", i)),
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := p.play(ctx)
+			if err != nil {
+				p.logger.Error("Bot stopped.", zap.Error(err))
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	err, failed := <-errs
+	if failed {
+		return fmt.Errorf("at least 1 bot failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/client"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/engine"
+	"go.uber.org/zap"
+)
+
+// maxConsecutiveFailures is the number of failed moves in a row after which a bot gives up
+const maxConsecutiveFailures = 5
+
+type player struct {
+	client *client.Client
+	skill  Skill
+	opts   RunOptions
+	rand   *rand.Rand
+	logger *zap.Logger
+
+	self     *client.Player
+	branchID string
+	solution [][]int
+
+	// proposalID is the last proposal to merge the bot's branch into Branch, if any
+	proposalID string
+}
+
+func (p *player) play(ctx context.Context) error {
+	err := p.join(ctx)
+	if err != nil {
+		return err
+	}
+
+	commits := 0
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(p.nextPause()):
+		}
+
+		board, err := p.readBoard(ctx)
+		if err != nil {
+			failures++
+			if failures >= maxConsecutiveFailures {
+				return fmt.Errorf("failed to read board: %w", err)
+			}
+			p.logger.Warn("Failed to read board.", zap.Error(err))
+			continue
+		}
+
+		move := p.nextMove(board)
+		if move == nil {
+			p.logger.Info("Board is completed.")
+			return p.mergeBack(ctx)
+		}
+
+		_, err = p.client.AddCommit(ctx, *move)
+		if err != nil {
+			failures++
+			if failures >= maxConsecutiveFailures {
+				return fmt.Errorf("failed to add commit: %w", err)
+			}
+			p.logger.Warn("Failed to add commit.", zap.Error(err))
+			continue
+		}
+		failures = 0
+		commits++
+
+		if p.opts.OwnBranch && p.opts.MergeEvery > 0 && commits%p.opts.MergeEvery == 0 {
+			err = p.mergeBack(ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *player) join(ctx context.Context) error {
+	self, err := p.client.Join(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to join: %w", err)
+	}
+	p.self = self
+	p.logger = p.logger.With(zap.String("player", self.DisplayName))
+
+	// Bots know the solution to tell apart their mistakes
+	sudoku, err := p.client.Sudoku(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the Sudoku: %w", err)
+	}
+	s, err := engine.NewSudoku(sudoku.Board)
+	if err != nil {
+		return fmt.Errorf("failed to solve the Sudoku: %w", err)
+	}
+	p.solution = s.Solution()
+
+	p.branchID = p.opts.Branch
+	if p.opts.OwnBranch {
+		branchID := "bot-" + strings.ToLower(strings.ReplaceAll(self.DisplayName, " ", "-"))
+		_, err = p.client.AddBranch(ctx, model.AddBranchInput{
+			ID:       branchID,
+			BranchID: &p.opts.Branch,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create branch %s: %w", branchID, err)
+		}
+		p.branchID = branchID
+	}
+
+	p.logger.Info("Joined the game.", zap.String("branchId", p.branchID))
+	return nil
+}
+
+// mergeBack merges the bot's own branch back into the branch it was created from, and catches up with it. A branch
+// only merged into through proposals, like master, is proposed the merge instead.
+func (p *player) mergeBack(ctx context.Context) error {
+	if p.branchID == p.opts.Branch {
+		return nil
+	}
+
+	branch, err := p.client.Branch(ctx, p.opts.Branch)
+	if err != nil {
+		return fmt.Errorf("failed to get branch %s: %w", p.opts.Branch, err)
+	}
+	if branch == nil {
+		return fmt.Errorf("branch %s not found", p.opts.Branch)
+	}
+	if branch.Protection != nil && branch.Protection.RequireApproval {
+		return p.proposeMergeBack(ctx)
+	}
+
+	_, err = p.client.MergeBranch(ctx, model.MergeBranchInput{
+		SourceBranchID: p.opts.Branch,
+		TargetBranchID: p.branchID,
+		AuthorID:       p.self.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge %s into %s: %w", p.branchID, p.opts.Branch, err)
+	}
+
+	err = p.catchUp(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("Merged back.", zap.String("branchId", p.branchID), zap.String("into", p.opts.Branch))
+	return nil
+}
+
+// proposeMergeBack catches up with the branch the bot's own branch was created from, and proposes to merge it back.
+// Nothing is proposed while the last proposal of the bot is still waiting.
+func (p *player) proposeMergeBack(ctx context.Context) error {
+	if p.proposalID != "" {
+		proposal, err := p.client.Proposal(ctx, p.proposalID)
+		if err != nil {
+			return fmt.Errorf("failed to get proposal %s: %w", p.proposalID, err)
+		}
+		if proposal != nil && (proposal.Status == model.ProposalStatusOpen || proposal.Status == model.ProposalStatusApproved) {
+			return nil
+		}
+	}
+
+	// Catch up first, so that the proposal has no conflicts
+	err := p.catchUp(ctx)
+	if err != nil {
+		return err
+	}
+
+	payload, err := p.client.OpenProposal(ctx, model.OpenProposalInput{
+		SourceBranchID: p.opts.Branch,
+		TargetBranchID: p.branchID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to propose merging %s into %s: %w", p.branchID, p.opts.Branch, err)
+	}
+	p.proposalID = payload.Proposal.ID
+
+	p.logger.Info("Proposed to merge back.", zap.String("branchId", p.branchID), zap.String("into", p.opts.Branch),
+		zap.String("proposalId", p.proposalID))
+	return nil
+}
+
+// catchUp merges the branch the bot's own branch was created from into it.
+func (p *player) catchUp(ctx context.Context) error {
+	_, err := p.client.MergeBranch(ctx, model.MergeBranchInput{
+		SourceBranchID: p.branchID,
+		TargetBranchID: p.opts.Branch,
+		AuthorID:       p.self.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge %s into %s: %w", p.opts.Branch, p.branchID, err)
+	}
+
+	return nil
+}
+
+func (p *player) readBoard(ctx context.Context) (engine.Board, error) {
+	branch, err := p.client.Branch(ctx, p.branchID)
+	if err != nil {
+		return nil, err
+	}
+	if branch == nil || branch.Commit == nil || branch.Commit.Blob == nil {
+		return nil, fmt.Errorf("branch %s has no board", p.branchID)
+	}
+
+	board := make(engine.Board, 9)
+	for i, row := range branch.Commit.Blob.Board {
+		board[i] = make([]engine.Cell, 9)
+		for j, cell := range row {
+			notes := make(engine.Notes, 9)
+			for _, note := range cell.Notes {
+				notes[note-1] = true
+			}
+			board[i][j] = engine.Cell{
+				Immutable: cell.Immutable,
+				Value:     cell.Val,
+				Notes:     notes,
+			}
+		}
+	}
+
+	return board, nil
+}
+
+// nextMove decides on the next commit to make, or nil if the board is completed.
+func (p *player) nextMove(board engine.Board) *model.AddCommitInput {
+	// Fix a wrong fill if the bot notices it
+	var mistakes [][2]int
+	empty := 0
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			cell := board[row][col]
+			if cell.Value == 0 {
+				empty++
+			} else if !cell.Immutable && cell.Value != p.solution[row][col] {
+				mistakes = append(mistakes, [2]int{row, col})
+			}
+		}
+	}
+	if empty == 0 && len(mistakes) == 0 {
+		return nil
+	}
+	if len(mistakes) > 0 && (empty == 0 || p.rand.Float64() < p.skill.FixRate) {
+		mistake := mistakes[p.rand.Intn(len(mistakes))]
+		p.logger.Debug("Erasing a mistake.", zap.Ints("cell", mistake[:]))
+		return &model.AddCommitInput{
+			BranchID: p.branchID,
+			Type:     model.CommitTypeRemoveFill,
+			Row:      mistake[0],
+			Col:      mistake[1],
+		}
+	}
+
+	// Find the next deduction, or peek at the solution if the bot's techniques are not enough
+	step, err := engine.FindStep(board, p.skill.Techniques...)
+	if err != nil {
+		step = p.guess(board)
+	}
+	candidates := engine.Candidates(board)[step.Row][step.Col].AsNumbers()
+
+	// Take a note on the cell first
+	if p.rand.Float64() < p.skill.NoteRate {
+		for _, candidate := range candidates {
+			if !board[step.Row][step.Col].Notes[candidate-1] {
+				return &model.AddCommitInput{
+					BranchID: p.branchID,
+					Type:     model.CommitTypeToggleNote,
+					Row:      step.Row,
+					Col:      step.Col,
+					Val:      intPtr(candidate),
+				}
+			}
+		}
+	}
+
+	// Make a mistake
+	val := step.Value
+	if p.rand.Float64() < p.skill.MistakeRate {
+		wrong := make([]int, 0, len(candidates))
+		for _, candidate := range candidates {
+			if candidate != step.Value {
+				wrong = append(wrong, candidate)
+			}
+		}
+		if len(wrong) > 0 {
+			val = wrong[p.rand.Intn(len(wrong))]
+		}
+	}
+
+	p.logger.Debug("Filling a cell.", zap.Ints("cell", []int{step.Row, step.Col}), zap.Int("val", val), zap.String("technique", string(step.Technique)))
+	return &model.AddCommitInput{
+		BranchID: p.branchID,
+		Type:     model.CommitTypeAddFill,
+		Row:      step.Row,
+		Col:      step.Col,
+		Val:      intPtr(val),
+	}
+}
+
+// guess picks the empty cell with the fewest candidates and fills it from the solution.
+func (p *player) guess(board engine.Board) *engine.Step {
+	var result *engine.Step
+	fewest := 10
+	candidates := engine.Candidates(board)
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			if board[row][col].Value != 0 {
+				continue
+			}
+			count := len(candidates[row][col].AsNumbers())
+			if count < fewest {
+				fewest = count
+				result = &engine.Step{
+					Row:   row,
+					Col:   col,
+					Value: p.solution[row][col],
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// nextPause returns a random pause around the configured pace.
+func (p *player) nextPause() time.Duration {
+	return time.Duration(float64(p.opts.Pace) * (0.5 + p.rand.Float64()))
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,292 @@
+package bot
+
+import (
+	"context"
+	"math/rand"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/nhan-ng/sudoku/internal/client"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/generated"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/middleware"
+	"github.com/nhan-ng/sudoku/internal/engine"
+	"github.com/nhan-ng/sudoku/internal/puzzle"
+)
+
+const testPuzzle = `070308100
+040100000
+000090082
+001000500
+000000230
+000283070
+094005000
+526000700
+000000009`
+
+// newTestServer serves the default game of the test puzzle, stopped at the end of the test.
+func newTestServer(t *testing.T) string {
+	p, err := puzzle.FromString(testPuzzle)
+	require.NoError(t, err, "puzzle")
+	signer := middleware.NewSigner([]byte("key"))
+	cfg, err := graph.NewResolver(graph.ResolverOptions{Puzzle: p, Signer: signer})
+	require.NoError(t, err, "resolver")
+
+	h := handler.New(generated.NewExecutableSchema(*cfg))
+	h.AddTransport(transport.POST{})
+	server := httptest.NewServer(middleware.SessionMiddleware(signer)(h))
+	t.Cleanup(func() {
+		server.Close()
+		_ = cfg.Resolvers.(*graph.Resolver).Close()
+	})
+
+	return server.URL
+}
+
+// newTestPlayer returns a bot of the skill, playing on the server if any.
+func newTestPlayer(endpoint string, skill Skill, opts RunOptions) *player {
+	return &player{
+		client: client.New(client.Options{Endpoint: endpoint}),
+		skill:  skill,
+		opts:   opts,
+		rand:   rand.New(rand.NewSource(1)),
+		logger: zap.NewNop(),
+	}
+}
+
+// testBoards returns the board of the test puzzle and its solution.
+func testBoards(t *testing.T) (engine.Board, [][]int) {
+	board, err := engine.ReadBoard(testPuzzle)
+	require.NoError(t, err, "board")
+	initial := make([][]int, 9)
+	for row := range board {
+		initial[row] = make([]int, 9)
+		for col, cell := range board[row] {
+			initial[row][col] = cell.Value
+		}
+	}
+	s, err := engine.NewSudoku(initial)
+	require.NoError(t, err, "sudoku")
+
+	return board, s.Solution()
+}
+
+// solved fills every cell of the board from the solution, but the cells left empty.
+func solved(board engine.Board, solution [][]int, empty ...[2]int) engine.Board {
+	board = board.Copy()
+	for row := range board {
+		for col := range board[row] {
+			board[row][col].Value = solution[row][col]
+		}
+	}
+	for _, cell := range empty {
+		board[cell[0]][cell[1]].Value = 0
+	}
+
+	return board
+}
+
+func TestPlayer_NextMove(t *testing.T) {
+	tests := []struct {
+		name string
+		// board is the board the bot reads
+		board    func(puzzle engine.Board, solution [][]int) engine.Board
+		skill    Skill
+		expected func(solution [][]int) *model.AddCommitInput
+	}{
+		{
+			name: "Completed",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				return solved(puzzle, solution)
+			},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return nil
+			},
+		},
+		{
+			name: "CompletedWithMistake",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				board := solved(puzzle, solution)
+				board[0][0].Value = solution[0][0]%9 + 1
+				return board
+			},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return &model.AddCommitInput{BranchID: "bot", Type: model.CommitTypeRemoveFill, Row: 0, Col: 0}
+			},
+		},
+		{
+			name: "MistakeNoticed",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				board := puzzle.Copy()
+				board[0][0].Value = solution[0][0]%9 + 1
+				return board
+			},
+			skill: Skill{FixRate: 1},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return &model.AddCommitInput{BranchID: "bot", Type: model.CommitTypeRemoveFill, Row: 0, Col: 0}
+			},
+		},
+		{
+			name: "Fill",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				return solved(puzzle, solution, [2]int{0, 0})
+			},
+			skill: Skill{Techniques: []engine.Technique{engine.TechniqueNakedSingle}},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return &model.AddCommitInput{BranchID: "bot", Type: model.CommitTypeAddFill, Row: 0, Col: 0, Val: intPtr(solution[0][0])}
+			},
+		},
+		{
+			name: "FillWithoutTechniques",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				return solved(puzzle, solution, [2]int{0, 0})
+			},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return &model.AddCommitInput{BranchID: "bot", Type: model.CommitTypeAddFill, Row: 0, Col: 0, Val: intPtr(solution[0][0])}
+			},
+		},
+		{
+			name: "Note",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				return solved(puzzle, solution, [2]int{0, 0})
+			},
+			skill: Skill{NoteRate: 1},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return &model.AddCommitInput{BranchID: "bot", Type: model.CommitTypeToggleNote, Row: 0, Col: 0, Val: intPtr(solution[0][0])}
+			},
+		},
+		{
+			name: "NoteTaken",
+			board: func(puzzle engine.Board, solution [][]int) engine.Board {
+				board := solved(puzzle, solution, [2]int{0, 0})
+				board[0][0].Notes[solution[0][0]-1] = true
+				return board
+			},
+			skill: Skill{NoteRate: 1},
+			expected: func(solution [][]int) *model.AddCommitInput {
+				return &model.AddCommitInput{BranchID: "bot", Type: model.CommitTypeAddFill, Row: 0, Col: 0, Val: intPtr(solution[0][0])}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			puzzle, solution := testBoards(t)
+			p := newTestPlayer("", tt.skill, RunOptions{})
+			p.branchID = "bot"
+			p.solution = solution
+
+			// Act
+			move := p.nextMove(tt.board(puzzle, solution))
+
+			// Assert
+			r.Equal(tt.expected(solution), move, "move")
+		})
+	}
+}
+
+func TestPlayer_Guess_PickCellWithFewestCandidates(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	board, solution := testBoards(t)
+	p := newTestPlayer("", Skill{}, RunOptions{})
+	p.solution = solution
+
+	// Act
+	step := p.guess(board)
+
+	// Assert
+	r.NotNil(step, "step")
+	r.Zero(board[step.Row][step.Col].Value, "empty cell")
+	r.Equal(solution[step.Row][step.Col], step.Value, "value of the solution")
+	candidates := engine.Candidates(board)
+	fewest := len(candidates[step.Row][step.Col].AsNumbers())
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			if board[row][col].Value == 0 {
+				r.GreaterOrEqual(len(candidates[row][col].AsNumbers()), fewest, "candidates of [%d][%d]", row, col)
+			}
+		}
+	}
+}
+
+func TestPlayer_MergeBack(t *testing.T) {
+	tests := []struct {
+		name string
+		// branchID is the branch the bot creates its own branch from, created by another player if not master
+		branchID string
+		merged   bool
+	}{
+		{
+			name:     "IntoBranch",
+			branchID: "shared",
+			merged:   true,
+		},
+		{
+			name:     "IntoMaster",
+			branchID: "master",
+			merged:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+			ctx := context.Background()
+
+			// Arrange, a bot with a commit on its own branch
+			endpoint := newTestServer(t)
+			if tt.branchID != "master" {
+				other := client.New(client.Options{Endpoint: endpoint})
+				_, err := other.Join(ctx)
+				r.NoError(err, "join other")
+				from := "master"
+				_, err = other.AddBranch(ctx, model.AddBranchInput{ID: tt.branchID, BranchID: &from})
+				r.NoError(err, "add branch")
+			}
+			p := newTestPlayer(endpoint, Skill{}, RunOptions{Branch: tt.branchID, OwnBranch: true})
+			r.NoError(p.join(ctx), "join")
+			board, err := p.readBoard(ctx)
+			r.NoError(err, "board")
+			_, err = p.client.AddCommit(ctx, *p.nextMove(board))
+			r.NoError(err, "commit")
+			before, err := p.client.Branch(ctx, tt.branchID)
+			r.NoError(err, "branch before")
+
+			// Act, twice as every MergeEvery commits
+			err = p.mergeBack(ctx)
+			r.NoError(err, "merge back")
+			err = p.mergeBack(ctx)
+			r.NoError(err, "merge back again")
+
+			// Assert
+			own, err := p.client.Branch(ctx, p.branchID)
+			r.NoError(err, "own branch")
+			after, err := p.client.Branch(ctx, tt.branchID)
+			r.NoError(err, "branch after")
+			open := model.ProposalStatusOpen
+			proposals, err := p.client.Proposals(ctx, &open)
+			r.NoError(err, "proposals")
+			if tt.merged {
+				r.Equal(own.CommitID, after.CommitID, "merged back")
+				r.Empty(proposals, "proposals")
+				return
+			}
+			r.Equal(before.CommitID, after.CommitID, "branch untouched")
+			r.Len(proposals, 1, "a single proposal")
+			r.Equal(tt.branchID, proposals[0].SourceBranchID, "proposal into the branch")
+			r.Equal(p.branchID, proposals[0].TargetBranchID, "proposal of the own branch")
+			r.Equal(p.proposalID, proposals[0].ID, "proposal of the bot")
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package bot
+
+import (
+	"fmt"
+
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+type Skill struct {
+	// MistakeRate is the probability of filling a wrong value instead of the deduced one
+	MistakeRate float64
+
+	// NoteRate is the probability of taking a note on the next cell instead of filling it
+	NoteRate float64
+
+	// FixRate is the probability of noticing a wrong fill on the board and erasing it
+	FixRate float64
+
+	// Techniques are the solving techniques the bot knows about
+	Techniques []engine.Technique
+}
+
+var skills = map[string]Skill{
+	"novice": {
+		MistakeRate: 0.15,
+		NoteRate:    0.4,
+		FixRate:     0.2,
+		Techniques:  []engine.Technique{engine.TechniqueNakedSingle},
+	},
+	"intermediate": {
+		MistakeRate: 0.05,
+		NoteRate:    0.2,
+		FixRate:     0.5,
+		Techniques:  []engine.Technique{engine.TechniqueNakedSingle, engine.TechniqueHiddenSingle},
+	},
+	"expert": {
+		MistakeRate: 0.01,
+		NoteRate:    0.05,
+		FixRate:     0.9,
+		Techniques:  engine.AllTechniques,
+	},
+}
+
+func GetSkill(name string) (Skill, error) {
+	skill, ok := skills[name]
+	if !ok {
+		return Skill{}, fmt.Errorf("unknown skill level '%s'", name)
+	}
+
+	return skill, nil
+}
@@ -0,0 +1,289 @@
+package eventbus
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Policy decides what happens to a subscriber whose queue is full when an event is published.
+type Policy string
+
+const (
+	// PolicyDropOldest drops the oldest queued event of the subscriber to make room for the new one
+	PolicyDropOldest Policy = "drop-oldest"
+
+	// PolicyDisconnect drops every queued event and disconnects the subscriber, which has to resync
+	PolicyDisconnect Policy = "disconnect"
+)
+
+// DefaultQueueSize is the number of pending events of a subscriber if not set in the options
+const DefaultQueueSize = 64
+
+var (
+	// ErrSlowConsumer is the error of a subscription disconnected for falling behind, its subscriber
+	// missed events and has to refetch the state it follows.
+	ErrSlowConsumer = errors.New("subscriber fell behind and was disconnected, resync required")
+
+	// ErrClosed is the error of a subscription ended by closing the bus.
+	ErrClosed = errors.New("event bus is closed")
+)
+
+// metrics add up the stats of every bus of the process, exposed at /debug/vars
+var metrics = expvar.NewMap("eventbus")
+
+// Options configures a Bus.
+type Options struct {
+	// QueueSize is the number of pending events of a subscriber, DefaultQueueSize if not set
+	QueueSize int
+
+	// Policy is applied to the subscribers with a full queue, PolicyDropOldest if not set
+	Policy Policy
+}
+
+// Stats are the counters of a Bus since its creation.
+type Stats struct {
+	Published    uint64
+	Delivered    uint64
+	Dropped      uint64
+	Disconnected uint64
+}
+
+// Bus fans out the events published on a topic to the subscribers of the topic. Publishing never blocks,
+// every subscriber has its own bounded queue drained by its own goroutine.
+type Bus struct {
+	queueSize int
+	policy    Policy
+
+	mu     sync.RWMutex
+	topics map[string]map[*Subscription]struct{}
+	closed bool
+
+	published    uint64
+	delivered    uint64
+	dropped      uint64
+	disconnected uint64
+}
+
+// Subscription receives the events of a topic in the order they were published.
+type Subscription struct {
+	bus   *Bus
+	topic string
+
+	mu     sync.Mutex
+	queue  []interface{}
+	closed bool
+	err    error
+
+	// ready signals the pump that events were queued, done that the subscription is closed
+	ready  chan struct{}
+	done   chan struct{}
+	events chan interface{}
+}
+
+// ParsePolicy returns the policy with the given name, PolicyDropOldest if empty.
+func ParsePolicy(policy string) (Policy, error) {
+	switch Policy(policy) {
+	case "":
+		return PolicyDropOldest, nil
+	case PolicyDropOldest, PolicyDisconnect:
+		return Policy(policy), nil
+	}
+
+	return "", fmt.Errorf("unknown slow consumer policy '%s'", policy)
+}
+
+// New creates a bus without any subscriber.
+func New(opts Options) *Bus {
+	queueSize := opts.QueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
+	}
+	policy := opts.Policy
+	if policy == "" {
+		policy = PolicyDropOldest
+	}
+
+	return &Bus{
+		queueSize: queueSize,
+		policy:    policy,
+		topics:    make(map[string]map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe starts a subscription to the events published on the topic from now on.
+func (b *Bus) Subscribe(topic string) *Subscription {
+	s := &Subscription{
+		bus:    b,
+		topic:  topic,
+		ready:  make(chan struct{}, 1),
+		done:   make(chan struct{}),
+		events: make(chan interface{}),
+	}
+
+	b.mu.Lock()
+	if b.closed {
+		s.close(ErrClosed)
+	} else {
+		subscribers, ok := b.topics[topic]
+		if !ok {
+			subscribers = make(map[*Subscription]struct{})
+			b.topics[topic] = subscribers
+		}
+		subscribers[s] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	go s.pump()
+
+	return s
+}
+
+// Publish queues the event for every subscriber of the topic without waiting for any of them.
+func (b *Bus) Publish(topic string, event interface{}) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	b.count(&b.published, "published")
+	for s := range b.topics[topic] {
+		s.enqueue(event)
+	}
+}
+
+// Stats returns the counters of the bus.
+func (b *Bus) Stats() Stats {
+	return Stats{
+		Published:    atomic.LoadUint64(&b.published),
+		Delivered:    atomic.LoadUint64(&b.delivered),
+		Dropped:      atomic.LoadUint64(&b.dropped),
+		Disconnected: atomic.LoadUint64(&b.disconnected),
+	}
+}
+
+// Close ends every subscription with ErrClosed, later subscriptions end right away.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	topics := b.topics
+	b.topics = make(map[string]map[*Subscription]struct{})
+	b.closed = true
+	b.mu.Unlock()
+
+	for _, subscribers := range topics {
+		for s := range subscribers {
+			s.close(ErrClosed)
+		}
+	}
+}
+
+func (b *Bus) unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subscribers := b.topics[s.topic]
+	delete(subscribers, s)
+	if len(subscribers) == 0 {
+		delete(b.topics, s.topic)
+	}
+}
+
+func (b *Bus) count(counter *uint64, name string) {
+	atomic.AddUint64(counter, 1)
+	metrics.Add(name, 1)
+}
+
+// Events returns the channel of the events, closed once the subscription ends.
+func (s *Subscription) Events() <-chan interface{} {
+	return s.events
+}
+
+// Err returns why the subscription ended: nil if closed by its subscriber, ErrSlowConsumer or ErrClosed otherwise.
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription, the pending events are dropped.
+func (s *Subscription) Close() {
+	s.bus.unsubscribe(s)
+	s.close(nil)
+}
+
+func (s *Subscription) enqueue(event interface{}) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+
+	if len(s.queue) >= s.bus.queueSize {
+		s.bus.count(&s.bus.dropped, "dropped")
+		if s.bus.policy == PolicyDisconnect {
+			s.mu.Unlock()
+			s.bus.count(&s.bus.disconnected, "disconnected")
+			// Publish holds the read lock of the bus, unsubscribe without waiting for it
+			go s.bus.unsubscribe(s)
+			s.close(ErrSlowConsumer)
+			return
+		}
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+	}
+	s.queue = append(s.queue, event)
+	s.mu.Unlock()
+
+	s.signal()
+}
+
+func (s *Subscription) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+
+	s.closed, s.err = true, err
+	s.queue = nil
+	close(s.done)
+}
+
+func (s *Subscription) signal() {
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pump delivers the queued events one at a time until the subscription is closed.
+func (s *Subscription) pump() {
+	defer close(s.events)
+
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return
+		}
+		if len(s.queue) == 0 {
+			s.mu.Unlock()
+			select {
+			case <-s.ready:
+			case <-s.done:
+			}
+			continue
+		}
+		event := s.queue[0]
+		s.queue[0] = nil
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+
+		select {
+		case s.events <- event:
+			s.bus.count(&s.bus.delivered, "delivered")
+		case <-s.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus_Publish_DeliverInOrder(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	bus := New(Options{QueueSize: 8})
+	sub := bus.Subscribe("commits")
+	other := bus.Subscribe("messages")
+	defer other.Close()
+
+	// Act
+	for i := 1; i <= 3; i++ {
+		bus.Publish("commits", i)
+	}
+
+	// Assert
+	for i := 1; i <= 3; i++ {
+		r.Equal(i, <-sub.Events(), "event")
+	}
+	sub.Close()
+	_, ok := <-sub.Events()
+	r.False(ok, "open")
+	r.NoError(sub.Err(), "err")
+	r.Equal(uint64(3), bus.Stats().Published, "published")
+}
+
+func TestBus_SlowConsumerDropOldest_KeepLatestEvents(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	bus := New(Options{QueueSize: 2, Policy: PolicyDropOldest})
+	sub := bus.Subscribe("commits")
+	defer sub.Close()
+
+	// Act, nobody reads while publishing
+	for i := 1; i <= 5; i++ {
+		bus.Publish("commits", i)
+	}
+
+	// Assert, the pump may already hold the first event
+	received := make([]interface{}, 0)
+	for len(received) == 0 || received[len(received)-1] != 5 {
+		received = append(received, <-sub.Events())
+	}
+	r.Equal([]interface{}{4, 5}, received[len(received)-2:], "received")
+	stats := bus.Stats()
+	r.Equal(uint64(5), stats.Published, "published")
+	r.Equal(uint64(5), uint64(len(received))+stats.Dropped, "received and dropped")
+	r.Zero(stats.Disconnected, "disconnected")
+}
+
+func TestBus_SlowConsumerDisconnect_ReturnErrSlowConsumer(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	bus := New(Options{QueueSize: 1, Policy: PolicyDisconnect})
+	sub := bus.Subscribe("commits")
+
+	// Act, nobody reads while publishing
+	for i := 1; i <= 3; i++ {
+		bus.Publish("commits", i)
+	}
+
+	// Assert
+	for range sub.Events() {
+	}
+	r.Equal(ErrSlowConsumer, sub.Err(), "err")
+	r.Equal(uint64(1), bus.Stats().Disconnected, "disconnected")
+}
+
+func TestBus_Close_EndSubscriptions(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	bus := New(Options{})
+	sub := bus.Subscribe("commits")
+
+	// Act
+	bus.Close()
+	late := bus.Subscribe("commits")
+
+	// Assert
+	_, ok := <-sub.Events()
+	r.False(ok, "open")
+	r.Equal(ErrClosed, sub.Err(), "err")
+	_, ok = <-late.Events()
+	r.False(ok, "late open")
+	r.Equal(ErrClosed, late.Err(), "late err")
+}
@@ -0,0 +1,103 @@
+package graph
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// commandQueueSize is the number of commands waiting for the goroutine of a game before callers block
+const commandQueueSize = 64
+
+// ErrGameClosed is returned by the commands sent to a game after it was closed
+var ErrGameClosed = errors.New("game is closed")
+
+// snapshot is the state of a game at some point, shared with every reader and never changed once published.
+type snapshot struct {
+	// players are keyed by their ID
+	players    map[string]*model.Player
+	playerList []*model.Player
+
+	// messages are oldest first, later messages are appended past the end of the slice
+	messages []*model.Message
+
+	// branches are the rules of the branches keyed by branch ID, the branches without rules are left out
+	branches map[string]*branchRules
+
+	// proposals are keyed by their ID
+	proposals map[string]*model.Proposal
+}
+
+// run processes the commands of the game one at a time until the game is closed.
+func (g *Game) run() {
+	defer close(g.stopped)
+
+	for {
+		select {
+		case command := <-g.commands:
+			command()
+		case <-g.closing:
+			return
+		}
+	}
+}
+
+// do runs the command on the goroutine of the game, the only one allowed to change the state of the game,
+// and waits for its result.
+func (g *Game) do(command func() error) error {
+	result := make(chan error, 1)
+	select {
+	case g.commands <- func() { result <- command() }:
+	case <-g.closing:
+		return ErrGameClosed
+	}
+
+	select {
+	case err := <-result:
+		return err
+	case <-g.stopped:
+		// The command may have run right before the game stopped
+		select {
+		case err := <-result:
+			return err
+		default:
+			return ErrGameClosed
+		}
+	}
+}
+
+// snapshot returns the latest state of the game, safe to read from any goroutine.
+func (g *Game) snapshot() *snapshot {
+	return g.state.Load().(*snapshot)
+}
+
+// publishSnapshot publishes the state after a command changed it, only called by the goroutine of the game.
+func (g *Game) publishSnapshot() {
+	players := make(map[string]*model.Player, len(g.players))
+	playerList := make([]*model.Player, 0, len(g.players))
+	for id, player := range g.players {
+		players[id] = player
+		playerList = append(playerList, player)
+	}
+	sort.Slice(playerList, func(i, j int) bool {
+		return playerList[i].DisplayName < playerList[j].DisplayName
+	})
+
+	branches := make(map[string]*branchRules, len(g.branches))
+	for id, rules := range g.branches {
+		branches[id] = rules
+	}
+	proposals := make(map[string]*model.Proposal, len(g.proposals))
+	for id, proposal := range g.proposals {
+		proposals[id] = proposal
+	}
+
+	g.state.Store(&snapshot{
+		players:    players,
+		playerList: playerList,
+		messages:   g.messages,
+		branches:   branches,
+		proposals:  proposals,
+	})
+}
@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+func TestGame_Do_AfterClose_ReturnErrGameClosed(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	r.NoError(game.Close(), "close")
+
+	// Act
+	ran := false
+	err := game.do(func() error {
+		ran = true
+		return nil
+	})
+
+	// Assert
+	r.Equal(ErrGameClosed, err, "err")
+	r.False(ran, "command ran")
+}
+
+func TestGame_Do_ConcurrentCommands_RunOneAtATimeInOrder(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	const callers, commands = 8, 100
+	game := newTestGame(t)
+
+	// Act, the commands change the same slice without a lock, the race detector catches commands that overlap
+	var ran [][2]int
+	var wg sync.WaitGroup
+	for caller := 0; caller < callers; caller++ {
+		wg.Add(1)
+		go func(caller int) {
+			defer wg.Done()
+			for i := 0; i < commands; i++ {
+				err := game.do(func() error {
+					ran = append(ran, [2]int{caller, i})
+					return nil
+				})
+				r.NoError(err, "command")
+			}
+		}(caller)
+	}
+	wg.Wait()
+
+	// Assert, every command ran once, those of a caller in the order they were sent
+	r.Len(ran, callers*commands, "commands")
+	next := make([]int, callers)
+	for _, command := range ran {
+		caller, i := command[0], command[1]
+		r.Equal(next[caller], i, "order of caller %d", caller)
+		next[caller]++
+	}
+}
+
+func TestGame_Snapshot_ReadWhileCommandsRun_SeeWholeStates(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	const players, readers = 50, 4
+	game := newTestGame(t)
+
+	// Act, add players one command at a time while other goroutines read the state
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for reader := 0; reader < readers; reader++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seen := 0
+			for {
+				state := game.snapshot()
+
+				// Assert, the players of a state always agree and never go back
+				r.Len(state.playerList, len(state.players), "players of a state")
+				r.GreaterOrEqual(len(state.players), seen, "players seen before")
+				r.True(sort.SliceIsSorted(state.playerList, func(i, j int) bool {
+					return state.playerList[i].DisplayName < state.playerList[j].DisplayName
+				}), "player list sorted")
+				seen = len(state.players)
+
+				select {
+				case <-done:
+					return
+				default:
+				}
+			}
+		}()
+	}
+	for i := 0; i < players; i++ {
+		err := game.do(func() error {
+			id := fmt.Sprintf("player-%02d", i)
+			game.players[id] = &model.Player{ID: id, DisplayName: id}
+			game.publishSnapshot()
+			return nil
+		})
+		r.NoError(err, "add player")
+	}
+	close(done)
+	wg.Wait()
+
+	// Assert
+	r.Len(game.snapshot().players, players, "players")
+}
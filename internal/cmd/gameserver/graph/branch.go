@@ -0,0 +1,427 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// defaultRequiredApprovals is the number of approvals merging a proposal when the branch doesn't set it
+const defaultRequiredApprovals = 1
+
+// branchRulesSection is the section of the repo config keeping the owner and the protection of the branches, with a
+// subsection per branch
+const branchRulesSection = "branch-rules"
+
+// branchRules are the owner and the protection of a branch, never changed once published.
+type branchRules struct {
+	// owner is the ID of the player who created the branch, empty for the branches without owner like master
+	owner string
+
+	model.BranchProtection
+}
+
+// masterProtection is the protection of the master branch, which has no owner to change it. Other branches are only
+// merged into master through proposals.
+var masterProtection = model.BranchProtection{NoForceReset: true, RequireApproval: true}
+
+// branchAction is what a player does to a branch, checked against the protection of the branch.
+type branchAction int
+
+const (
+	// actionCommit adds commits on top of the branch
+	actionCommit branchAction = iota
+
+	// actionMerge merges another branch into the branch
+	actionMerge
+
+	// actionApprovedMerge merges another branch into the branch once a proposal was approved
+	actionApprovedMerge
+
+	// actionForceReset moves the branch to a commit which doesn't contain its tip, like a reset or a rebase
+	actionForceReset
+
+	// actionManage renames the branch or changes its protection
+	actionManage
+
+	// actionDelete deletes the branch, both managing it and dropping its commits
+	actionDelete
+)
+
+// authorize checks the protection of the branch lets the player do the action. The player is nil when unknown.
+// Nobody manages or deletes the branches without owner, like master.
+func (g *Game) authorize(branchID string, player *model.Player, action branchAction) error {
+	rules, ok := g.snapshot().branches[branchID]
+	if !ok {
+		rules = &branchRules{}
+	}
+
+	owner := rules.owner != "" && player != nil && player.ID == rules.owner
+	if !owner && (rules.OwnerOnly || action == actionManage || action == actionDelete) {
+		return gqlerrors.ErrBranchOwnerOnly(branchID)
+	}
+	if rules.NoForceReset && (action == actionForceReset || action == actionDelete) {
+		return gqlerrors.ErrBranchNoForceReset(branchID)
+	}
+	if rules.RequireApproval && action == actionMerge {
+		return gqlerrors.ErrBranchRequiresApproval(branchID)
+	}
+
+	return nil
+}
+
+// validateBranchName checks the name is a valid git branch name, see git check-ref-format.
+func validateBranchName(name string) error {
+	switch {
+	case name == "", name == "@", name == "HEAD",
+		strings.HasPrefix(name, "-"), strings.HasPrefix(name, "/"), strings.HasSuffix(name, "/"), strings.HasSuffix(name, "."),
+		strings.Contains(name, ".."), strings.Contains(name, "//"), strings.Contains(name, "@{"),
+		strings.ContainsAny(name, " ~^:?*[\\"):
+		return gqlerrors.ErrInvalidBranchName(name)
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f {
+			return gqlerrors.ErrInvalidBranchName(name)
+		}
+	}
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return gqlerrors.ErrInvalidBranchName(name)
+		}
+	}
+
+	return nil
+}
+
+func readBranchRules(repo *git.Repository) (map[string]*branchRules, error) {
+	cfg, err := repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repo config: %w", err)
+	}
+
+	branches := map[string]*branchRules{
+		masterBranch: {BranchProtection: masterProtection},
+	}
+	if !cfg.Raw.HasSection(branchRulesSection) {
+		return branches, nil
+	}
+	for _, subsection := range cfg.Raw.Section(branchRulesSection).Subsections {
+		// Nobody manages master, it keeps its protection whatever an older config says
+		if subsection.Name == masterBranch {
+			continue
+		}
+		rules := &branchRules{owner: subsection.Option("owner")}
+		rules.OwnerOnly, _ = strconv.ParseBool(subsection.Option("ownerOnly"))
+		rules.NoForceReset, _ = strconv.ParseBool(subsection.Option("noForceReset"))
+		rules.RequireApproval, _ = strconv.ParseBool(subsection.Option("requireApproval"))
+		rules.RequiredApprovals, _ = strconv.Atoi(subsection.Option("requiredApprovals"))
+		branches[subsection.Name] = rules
+	}
+
+	return branches, nil
+}
+
+// saveBranchRules writes the rules of every branch to the repo config and publishes them, only called by the
+// goroutine of the game.
+func (g *Game) saveBranchRules() error {
+	cfg, err := g.repo.Config()
+	if err != nil {
+		return fmt.Errorf("failed to read repo config: %w", err)
+	}
+
+	cfg.Raw.RemoveSection(branchRulesSection)
+	section := cfg.Raw.Section(branchRulesSection)
+	for branchID, rules := range g.branches {
+		if branchID == masterBranch {
+			continue
+		}
+		subsection := section.Subsection(branchID)
+		subsection.SetOption("owner", rules.owner)
+		subsection.SetOption("ownerOnly", strconv.FormatBool(rules.OwnerOnly))
+		subsection.SetOption("noForceReset", strconv.FormatBool(rules.NoForceReset))
+		subsection.SetOption("requireApproval", strconv.FormatBool(rules.RequireApproval))
+		subsection.SetOption("requiredApprovals", strconv.Itoa(rules.RequiredApprovals))
+	}
+
+	err = g.repo.Storer.SetConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to write repo config: %w", err)
+	}
+	g.publishSnapshot()
+
+	return nil
+}
+
+// releaseBranches drops the ownership of the branches of a player leaving the game, only called by the goroutine of
+// the game. The branches keep their protection, except being left to their owner which would lock them for good.
+func (g *Game) releaseBranches(playerID string) error {
+	released := false
+	for branchID, rules := range g.branches {
+		if rules.owner != playerID {
+			continue
+		}
+		// Rules are shared with the snapshots, replace them instead of changing them
+		protection := rules.BranchProtection
+		protection.OwnerOnly = false
+		g.branches[branchID] = &branchRules{BranchProtection: protection}
+		released = true
+	}
+	if !released {
+		return nil
+	}
+
+	return g.saveBranchRules()
+}
+
+// BranchRules returns the owner and the protection of the branch, a branch without rules isn't protected.
+func (g *Game) BranchRules(branchID string) (string, model.BranchProtection) {
+	var rules branchRules
+	if current, ok := g.snapshot().branches[branchID]; ok {
+		rules = *current
+	}
+	if rules.RequiredApprovals < 1 {
+		rules.RequiredApprovals = defaultRequiredApprovals
+	}
+
+	return rules.owner, rules.BranchProtection
+}
+
+// DeleteBranch deletes the branch, along with its rules and the merges waiting on it. The branch isn't deleted while
+// proposals are waiting on it.
+func (g *Game) DeleteBranch(branchID string, player *model.Player) (*plumbing.Reference, error) {
+	if branchID == masterBranch {
+		return nil, gqlerrors.ErrDefaultBranch(branchID)
+	}
+
+	var ref *plumbing.Reference
+	err := g.do(func() error {
+		var err error
+		ref, err = g.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+		if err != nil {
+			return gqlerrors.ErrBranchNotFound(branchID)
+		}
+		err = g.authorize(branchID, player, actionDelete)
+		if err != nil {
+			return err
+		}
+		if proposal := g.waitingProposal(branchID); proposal != nil {
+			return gqlerrors.ErrBranchHasProposals(branchID, proposal.ID)
+		}
+
+		err = g.repo.Storer.RemoveReference(ref.Name())
+		if err != nil {
+			return fmt.Errorf("failed to delete branch %s: %w", branchID, err)
+		}
+		g.dropMerges(branchID)
+		if _, ok := g.branches[branchID]; ok {
+			delete(g.branches, branchID)
+			return g.saveBranchRules()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g.NotifyBranchEvent(&model.BranchEvent{
+		Type:        model.BranchEventTypeDeleted,
+		BranchID:    branchID,
+		OldCommitID: StringPtr(ref.Hash().String()),
+		Player:      player,
+	})
+
+	return ref, nil
+}
+
+// RenameBranch moves the branch and its rules to the new name. The merges waiting on the branch are dropped, the
+// branch isn't renamed while proposals are waiting on it.
+func (g *Game) RenameBranch(branchID, newBranchID string, player *model.Player) (*plumbing.Reference, error) {
+	if branchID == masterBranch {
+		return nil, gqlerrors.ErrDefaultBranch(branchID)
+	}
+	err := validateBranchName(newBranchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var newRef *plumbing.Reference
+	err = g.do(func() error {
+		ref, err := g.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+		if err != nil {
+			return gqlerrors.ErrBranchNotFound(branchID)
+		}
+		err = g.authorize(branchID, player, actionManage)
+		if err != nil {
+			return err
+		}
+		if proposal := g.waitingProposal(branchID); proposal != nil {
+			return gqlerrors.ErrBranchHasProposals(branchID, proposal.ID)
+		}
+		_, err = g.repo.Reference(plumbing.NewBranchReferenceName(newBranchID), false)
+		if err == nil {
+			return gqlerrors.ErrBranchAlreadyExists(newBranchID)
+		}
+
+		newRef = plumbing.NewHashReference(plumbing.NewBranchReferenceName(newBranchID), ref.Hash())
+		err = g.repo.Storer.SetReference(newRef)
+		if err != nil {
+			return fmt.Errorf("failed to create branch %s: %w", newBranchID, err)
+		}
+		err = g.repo.Storer.RemoveReference(ref.Name())
+		if err != nil {
+			return fmt.Errorf("failed to delete branch %s: %w", branchID, err)
+		}
+		g.dropMerges(branchID)
+		if rules, ok := g.branches[branchID]; ok {
+			g.branches[newBranchID] = rules
+			delete(g.branches, branchID)
+			return g.saveBranchRules()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	g.NotifyBranchEvent(&model.BranchEvent{
+		Type:           model.BranchEventTypeRenamed,
+		BranchID:       newBranchID,
+		SourceBranchID: StringPtr(branchID),
+		NewCommitID:    StringPtr(newRef.Hash().String()),
+		Player:         player,
+	})
+
+	return newRef, nil
+}
+
+// ResetBranch moves the branch to the commit. Moving it to a commit which doesn't contain its tip is a force reset.
+func (g *Game) ResetBranch(branchID, commitID string, player *model.Player) (*plumbing.Reference, error) {
+	ref, err := g.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+	if err != nil {
+		return nil, gqlerrors.ErrBranchNotFound(branchID)
+	}
+	commit, err := g.repo.CommitObject(plumbing.NewHash(commitID))
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(commitID)
+	}
+	tip, err := g.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit %s: %w", ref.Hash().String(), err)
+	}
+
+	action := actionCommit
+	fastForward, err := tip.IsAncestor(commit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the history of commit %s: %w", commitID, err)
+	}
+	if !fastForward {
+		action = actionForceReset
+	}
+	err = g.authorize(branchID, player, action)
+	if err != nil {
+		return nil, err
+	}
+
+	newRef := plumbing.NewHashReference(ref.Name(), commit.Hash)
+	err = g.repo.Storer.CheckAndSetReference(newRef, ref)
+	if errors.Is(err, storage.ErrReferenceHasChanged) {
+		return nil, gqlerrors.ErrBranchChanged(branchID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset branch %s: %w", branchID, err)
+	}
+	g.NotifyBranchEvent(&model.BranchEvent{
+		Type:        model.BranchEventTypeReset,
+		BranchID:    branchID,
+		OldCommitID: StringPtr(ref.Hash().String()),
+		NewCommitID: StringPtr(newRef.Hash().String()),
+		Player:      player,
+	})
+
+	return newRef, nil
+}
+
+// SetBranchProtection changes the protection of the branch, the fields left out keep their current value.
+func (g *Game) SetBranchProtection(input model.SetBranchProtectionInput, player *model.Player) (*plumbing.Reference, error) {
+	if input.RequiredApprovals != nil && *input.RequiredApprovals < 1 {
+		return nil, gqlerrors.ErrInvalidRequiredApprovals()
+	}
+
+	var ref *plumbing.Reference
+	err := g.do(func() error {
+		var err error
+		ref, err = g.repo.Reference(plumbing.NewBranchReferenceName(input.BranchID), false)
+		if err != nil {
+			return gqlerrors.ErrBranchNotFound(input.BranchID)
+		}
+		err = g.authorize(input.BranchID, player, actionManage)
+		if err != nil {
+			return err
+		}
+
+		rules := &branchRules{}
+		if current, ok := g.branches[input.BranchID]; ok {
+			*rules = *current
+		}
+		if input.OwnerOnly != nil {
+			rules.OwnerOnly = *input.OwnerOnly
+		}
+		if input.NoForceReset != nil {
+			rules.NoForceReset = *input.NoForceReset
+		}
+		if input.RequireApproval != nil {
+			rules.RequireApproval = *input.RequireApproval
+		}
+		if input.RequiredApprovals != nil {
+			rules.RequiredApprovals = *input.RequiredApprovals
+		}
+		g.branches[input.BranchID] = rules
+
+		return g.saveBranchRules()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ref, nil
+}
+
+// waitingProposal returns a proposal still open or approved merging the branch, either way, only called by the
+// goroutine of the game.
+func (g *Game) waitingProposal(branchID string) *model.Proposal {
+	for _, proposal := range g.proposals {
+		waiting := proposal.Status == model.ProposalStatusOpen || proposal.Status == model.ProposalStatusApproved
+		if waiting && (proposal.SourceBranchID == branchID || proposal.TargetBranchID == branchID) {
+			return proposal
+		}
+	}
+
+	return nil
+}
+
+// dropMerges drops the merges waiting on the branch, only called by the goroutine of the game. The proposals of the
+// merges dropped are open again.
+func (g *Game) dropMerges(branchID string) {
+	for id, m := range g.merges {
+		if m.SourceBranchID != branchID && m.TargetBranchID != branchID {
+			continue
+		}
+
+		delete(g.merges, id)
+		if m.proposalID != "" {
+			g.updateProposal(m.proposalID, func(p *model.Proposal) error {
+				p.Status = model.ProposalStatusOpen
+				p.PendingMerge = nil
+				return nil
+			})
+		}
+	}
+}
@@ -0,0 +1,454 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/merge"
+)
+
+// ownBranch creates the branch at the tip of master, owned by the player with the protection.
+func ownBranch(t *testing.T, game *Game, branchID string, owner *model.Player, protection model.BranchProtection) {
+	addBranch(t, game, branchID, masterBranch)
+	err := game.do(func() error {
+		game.branches[branchID] = &branchRules{owner: owner.ID, BranchProtection: protection}
+		return game.saveBranchRules()
+	})
+	require.NoError(t, err, "rules of %s", branchID)
+}
+
+func TestGame_Authorize(t *testing.T) {
+	game := newTestGame(t)
+	addBranch(t, game, "anonymous", masterBranch)
+	ownBranch(t, game, "open", alice, model.BranchProtection{})
+	ownBranch(t, game, "owner-only", alice, model.BranchProtection{OwnerOnly: true})
+	ownBranch(t, game, "no-force-reset", alice, model.BranchProtection{NoForceReset: true})
+	ownBranch(t, game, "require-approval", alice, model.BranchProtection{RequireApproval: true})
+
+	tests := []struct {
+		name     string
+		branchID string
+		player   *model.Player
+		action   branchAction
+		err      error
+	}{
+		{
+			name:     "WithoutOwner_Commit",
+			branchID: "anonymous",
+			player:   bob,
+			action:   actionCommit,
+		},
+		{
+			name:     "WithoutOwner_Manage",
+			branchID: "anonymous",
+			player:   bob,
+			action:   actionManage,
+			err:      gqlerrors.ErrBranchOwnerOnly("anonymous"),
+		},
+		{
+			name:     "WithoutOwner_Delete",
+			branchID: "anonymous",
+			player:   bob,
+			action:   actionDelete,
+			err:      gqlerrors.ErrBranchOwnerOnly("anonymous"),
+		},
+		{
+			name:     "Master_Merge",
+			branchID: masterBranch,
+			player:   bob,
+			action:   actionMerge,
+			err:      gqlerrors.ErrBranchRequiresApproval(masterBranch),
+		},
+		{
+			name:     "Master_ApprovedMerge",
+			branchID: masterBranch,
+			player:   bob,
+			action:   actionApprovedMerge,
+		},
+		{
+			name:     "Master_ForceReset",
+			branchID: masterBranch,
+			player:   bob,
+			action:   actionForceReset,
+			err:      gqlerrors.ErrBranchNoForceReset(masterBranch),
+		},
+		{
+			name:     "Master_Manage",
+			branchID: masterBranch,
+			player:   alice,
+			action:   actionManage,
+			err:      gqlerrors.ErrBranchOwnerOnly(masterBranch),
+		},
+		{
+			name:     "Owner_Manage",
+			branchID: "open",
+			player:   alice,
+			action:   actionManage,
+		},
+		{
+			name:     "Other_Manage",
+			branchID: "open",
+			player:   bob,
+			action:   actionManage,
+			err:      gqlerrors.ErrBranchOwnerOnly("open"),
+		},
+		{
+			name:     "Unknown_Delete",
+			branchID: "open",
+			action:   actionDelete,
+			err:      gqlerrors.ErrBranchOwnerOnly("open"),
+		},
+		{
+			name:     "Other_Commit",
+			branchID: "open",
+			player:   bob,
+			action:   actionCommit,
+		},
+		{
+			name:     "OwnerOnly_OwnerCommit",
+			branchID: "owner-only",
+			player:   alice,
+			action:   actionCommit,
+		},
+		{
+			name:     "OwnerOnly_OtherCommit",
+			branchID: "owner-only",
+			player:   bob,
+			action:   actionCommit,
+			err:      gqlerrors.ErrBranchOwnerOnly("owner-only"),
+		},
+		{
+			name:     "NoForceReset_OwnerDelete",
+			branchID: "no-force-reset",
+			player:   alice,
+			action:   actionDelete,
+			err:      gqlerrors.ErrBranchNoForceReset("no-force-reset"),
+		},
+		{
+			name:     "NoForceReset_Commit",
+			branchID: "no-force-reset",
+			player:   bob,
+			action:   actionCommit,
+		},
+		{
+			name:     "RequireApproval_Merge",
+			branchID: "require-approval",
+			player:   alice,
+			action:   actionMerge,
+			err:      gqlerrors.ErrBranchRequiresApproval("require-approval"),
+		},
+		{
+			name:     "RequireApproval_ApprovedMerge",
+			branchID: "require-approval",
+			player:   bob,
+			action:   actionApprovedMerge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			err := game.authorize(tt.branchID, tt.player, tt.action)
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+		})
+	}
+}
+
+func TestGame_SetBranchProtection(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	ownBranch(t, game, "alice", alice, model.BranchProtection{})
+	yes, two, zero := true, 2, 0
+
+	// Act
+	_, err := game.SetBranchProtection(model.SetBranchProtectionInput{BranchID: "alice", OwnerOnly: &yes}, alice)
+	r.NoError(err, "owner only")
+	_, err = game.SetBranchProtection(model.SetBranchProtectionInput{BranchID: "alice", RequiredApprovals: &two}, alice)
+	r.NoError(err, "required approvals")
+	_, otherErr := game.SetBranchProtection(model.SetBranchProtectionInput{BranchID: "alice", OwnerOnly: &yes}, bob)
+	_, masterErr := game.SetBranchProtection(model.SetBranchProtectionInput{BranchID: masterBranch, NoForceReset: &yes}, alice)
+	_, zeroErr := game.SetBranchProtection(model.SetBranchProtectionInput{BranchID: "alice", RequiredApprovals: &zero}, alice)
+
+	// Assert
+	owner, protection := game.BranchRules("alice")
+	r.Equal(alice.ID, owner, "owner")
+	r.Equal(model.BranchProtection{OwnerOnly: true, RequiredApprovals: 2}, protection, "fields left out are kept")
+	r.Equal(gqlerrors.ErrBranchOwnerOnly("alice"), otherErr, "other player")
+	r.Equal(gqlerrors.ErrBranchOwnerOnly(masterBranch), masterErr, "master")
+	r.Equal(gqlerrors.ErrInvalidRequiredApprovals(), zeroErr, "no approvals")
+	owner, protection = game.BranchRules(masterBranch)
+	r.Empty(owner, "master owner")
+	r.Equal(model.BranchProtection{NoForceReset: true, RequireApproval: true, RequiredApprovals: defaultRequiredApprovals}, protection, "master protection")
+	saved, err := readBranchRules(game.repo)
+	r.NoError(err, "saved rules")
+	r.Equal(*game.snapshot().branches["alice"], *saved["alice"], "saved")
+}
+
+func TestGame_RenameBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		branchID    string
+		newBranchID string
+		player      *model.Player
+		// proposal opens a proposal merging the branch into master
+		proposal bool
+		err      error
+	}{
+		{
+			name:        "Renamed",
+			branchID:    "alice",
+			newBranchID: "alice/renamed",
+			player:      alice,
+		},
+		{
+			name:        "Master",
+			branchID:    masterBranch,
+			newBranchID: "main",
+			player:      alice,
+			err:         gqlerrors.ErrDefaultBranch(masterBranch),
+		},
+		{
+			name:        "InvalidName",
+			branchID:    "alice",
+			newBranchID: "alice..renamed",
+			player:      alice,
+			err:         gqlerrors.ErrInvalidBranchName("alice..renamed"),
+		},
+		{
+			name:        "AlreadyExists",
+			branchID:    "alice",
+			newBranchID: "bob",
+			player:      alice,
+			err:         gqlerrors.ErrBranchAlreadyExists("bob"),
+		},
+		{
+			name:        "NotOwner",
+			branchID:    "alice",
+			newBranchID: "alice/renamed",
+			player:      bob,
+			err:         gqlerrors.ErrBranchOwnerOnly("alice"),
+		},
+		{
+			name:        "WaitingProposal",
+			branchID:    "alice",
+			newBranchID: "alice/renamed",
+			player:      alice,
+			proposal:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			ownBranch(t, game, "alice", alice, model.BranchProtection{OwnerOnly: true})
+			ownBranch(t, game, "bob", bob, model.BranchProtection{})
+			commit(t, game, "alice", alice, fill(0, 0, 5))
+			commit(t, game, masterBranch, bob, fill(0, 0, 4))
+			payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+			r.NoError(err, "merge")
+			expectedErr := tt.err
+			if tt.proposal {
+				proposal, err := game.OpenProposal(model.OpenProposalInput{SourceBranchID: masterBranch, TargetBranchID: "alice"}, bob)
+				r.NoError(err, "proposal")
+				expectedErr = gqlerrors.ErrBranchHasProposals("alice", proposal.ID)
+			}
+			tipID := tip(t, game, tt.branchID)
+
+			// Act
+			ref, err := game.RenameBranch(tt.branchID, tt.newBranchID, tt.player)
+
+			// Assert
+			r.Equal(expectedErr, err, "err")
+			if expectedErr != nil {
+				r.Equal(tipID, tip(t, game, tt.branchID), "branch kept")
+				return
+			}
+			r.Equal(plumbing.NewBranchReferenceName(tt.newBranchID), ref.Name(), "name")
+			r.Equal(tipID, tip(t, game, tt.newBranchID), "tip")
+			_, err = game.repo.Reference(plumbing.NewBranchReferenceName(tt.branchID), false)
+			r.Error(err, "old branch")
+			owner, protection := game.BranchRules(tt.newBranchID)
+			r.Equal(alice.ID, owner, "owner")
+			r.True(protection.OwnerOnly, "protection")
+			r.NotContains(game.snapshot().branches, tt.branchID, "old rules")
+			r.NotContains(game.merges, payload.PendingMerge.ID, "pending merge dropped")
+		})
+	}
+}
+
+func TestGame_DeleteBranch(t *testing.T) {
+	tests := []struct {
+		name       string
+		branchID   string
+		player     *model.Player
+		protection model.BranchProtection
+		// proposal opens a proposal merging the branch into master
+		proposal bool
+		err      error
+	}{
+		{
+			name:     "Deleted",
+			branchID: "alice",
+			player:   alice,
+		},
+		{
+			name:     "Master",
+			branchID: masterBranch,
+			player:   alice,
+			err:      gqlerrors.ErrDefaultBranch(masterBranch),
+		},
+		{
+			name:     "NotOwner",
+			branchID: "alice",
+			player:   bob,
+			err:      gqlerrors.ErrBranchOwnerOnly("alice"),
+		},
+		{
+			name:       "NoForceReset",
+			branchID:   "alice",
+			player:     alice,
+			protection: model.BranchProtection{NoForceReset: true},
+			err:        gqlerrors.ErrBranchNoForceReset("alice"),
+		},
+		{
+			name:     "NotFound",
+			branchID: "missing",
+			player:   alice,
+			err:      gqlerrors.ErrBranchNotFound("missing"),
+		},
+		{
+			name:     "WaitingProposal",
+			branchID: "alice",
+			player:   alice,
+			proposal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			ownBranch(t, game, "alice", alice, tt.protection)
+			ownBranch(t, game, "bob", bob, model.BranchProtection{})
+			commit(t, game, "alice", alice, fill(0, 0, 5))
+			commit(t, game, "bob", bob, fill(0, 0, 4))
+			payload, err := game.MergeBranch("bob", "alice", merge.Replay{}, bob, "")
+			r.NoError(err, "merge")
+			expectedErr := tt.err
+			if tt.proposal {
+				proposal, err := game.OpenProposal(model.OpenProposalInput{SourceBranchID: masterBranch, TargetBranchID: "alice"}, bob)
+				r.NoError(err, "proposal")
+				expectedErr = gqlerrors.ErrBranchHasProposals("alice", proposal.ID)
+			}
+
+			// Act
+			ref, err := game.DeleteBranch(tt.branchID, tt.player)
+
+			// Assert
+			r.Equal(expectedErr, err, "err")
+			if expectedErr != nil {
+				r.Contains(game.merges, payload.PendingMerge.ID, "pending merge kept")
+				return
+			}
+			r.Equal(plumbing.NewBranchReferenceName(tt.branchID), ref.Name(), "name")
+			_, err = game.repo.Reference(ref.Name(), false)
+			r.Error(err, "branch")
+			r.NotContains(game.snapshot().branches, tt.branchID, "rules")
+			r.NotContains(game.merges, payload.PendingMerge.ID, "pending merge dropped")
+		})
+	}
+}
+
+func TestGame_ResetBranch(t *testing.T) {
+	tests := []struct {
+		name       string
+		backward   bool
+		protection model.BranchProtection
+		err        error
+	}{
+		{
+			name: "Forward",
+		},
+		{
+			name:       "ForwardWithoutForceReset",
+			protection: model.BranchProtection{NoForceReset: true},
+		},
+		{
+			name:     "Backward",
+			backward: true,
+		},
+		{
+			name:       "BackwardWithoutForceReset",
+			backward:   true,
+			protection: model.BranchProtection{NoForceReset: true},
+			err:        gqlerrors.ErrBranchNoForceReset("alice"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange, master is ahead of alice
+			game := newTestGame(t)
+			ownBranch(t, game, "alice", alice, tt.protection)
+			commit(t, game, masterBranch, bob, fill(0, 0, 4))
+			target := tip(t, game, masterBranch)
+			if tt.backward {
+				addBranch(t, game, "old", "alice")
+				commit(t, game, "alice", alice, fill(0, 0, 5))
+				target = tip(t, game, "old")
+			}
+			before := tip(t, game, "alice")
+
+			// Act
+			_, err := game.ResetBranch("alice", target.String(), alice)
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+			if tt.err != nil {
+				r.Equal(before, tip(t, game, "alice"), "tip kept")
+				return
+			}
+			r.Equal(target, tip(t, game, "alice"), "tip")
+		})
+	}
+}
+
+func TestMutationResolver_AddBranch(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	res, game := newTestResolver(t)
+	ctx, player := join(t, res)
+	from := masterBranch
+
+	// Act
+	_, anonymousErr := (&mutationResolver{res}).AddBranch(context.Background(), model.AddBranchInput{GameID: DefaultGameID, ID: "anonymous", BranchID: &from})
+	payload, err := (&mutationResolver{res}).AddBranch(ctx, model.AddBranchInput{GameID: DefaultGameID, ID: "player", BranchID: &from})
+
+	// Assert
+	r.Equal(gqlerrors.ErrNotJoined(DefaultGameID), anonymousErr, "anonymous")
+	r.NoError(err, "player")
+	r.Equal("player", payload.Branch.ID, "branch")
+	_, err = game.repo.Reference(plumbing.NewBranchReferenceName("anonymous"), false)
+	r.Error(err, "anonymous branch")
+	owner, _ := game.BranchRules("player")
+	r.Equal(player.ID, owner, "owner")
+}
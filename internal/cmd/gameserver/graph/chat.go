@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/google/uuid"
+
+	"github.com/nhan-ng/sudoku/internal/chat"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+const (
+	// maxMessageLength is the longest message in characters
+	maxMessageLength = 1000
+
+	// maxMessages is the number of messages kept in the history of a game, older ones are dropped
+	maxMessages = 1000
+
+	// defaultMessagesLimit is the number of messages returned by the messages query if not set
+	defaultMessagesLimit = 50
+
+	// commitIDLength is the length of a full, unabbreviated commit ID
+	commitIDLength = 40
+
+	// abbreviatedCommitIDLength is the length of the shortest commit ID linked in messages, as git abbreviates them
+	abbreviatedCommitIDLength = 7
+)
+
+// AddMessage adds a message of the player to the chat of the branch, or of the whole game if branchID is empty.
+func (g *Game) AddMessage(player *model.Player, branchID, text string) (*model.Message, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxMessageLength {
+		return nil, gqlerrors.ErrInvalidMessage(maxMessageLength)
+	}
+
+	message := &model.Message{
+		ID:        uuid.NewString(),
+		Author:    player,
+		Text:      text,
+		Links:     ConvertLinks(chat.ParseLinks(text, g.resolveCommit)),
+		CreatedAt: time.Now(),
+	}
+	if branchID != "" {
+		message.BranchID = StringPtr(branchID)
+	}
+
+	g.messages = append(g.messages, message)
+	if len(g.messages) > maxMessages {
+		g.messages = g.messages[len(g.messages)-maxMessages:]
+	}
+	g.publishSnapshot()
+
+	g.events.Publish(messagesTopic(branchID), message)
+
+	return message, nil
+}
+
+// Messages returns the last messages of the chat of the branch, or of the whole game if branchID is empty, oldest first.
+func (g *Game) Messages(branchID string, limit int) []*model.Message {
+	messages := g.snapshot().messages
+	result := make([]*model.Message, 0)
+	for i := len(messages) - 1; i >= 0 && len(result) < limit; i-- {
+		message := messages[i]
+		if messageBranchID(message) == branchID {
+			result = append(result, message)
+		}
+	}
+
+	// Restore the chronological order
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return result
+}
+
+// resolveCommit finds the commit of the game with the given ID or ID prefix.
+func (g *Game) resolveCommit(prefix string) (string, bool) {
+	if len(prefix) == commitIDLength {
+		_, err := g.repo.CommitObject(plumbing.NewHash(prefix))
+		return prefix, err == nil
+	}
+
+	commitID, ok := g.repo.Storer.(*lockedStorer).ResolveCommit(prefix)
+	return commitID.String(), ok
+}
+
+func messageBranchID(message *model.Message) string {
+	if message.BranchID == nil {
+		return ""
+	}
+
+	return *message.BranchID
+}
+
+func ConvertLinks(links []chat.Link) []*model.MessageLink {
+	result := make([]*model.MessageLink, 0, len(links))
+	for _, link := range links {
+		l := &model.MessageLink{
+			Type:  model.MessageLinkType(link.Type),
+			Text:  link.Text,
+			Start: link.Start,
+			End:   link.End,
+		}
+		switch link.Type {
+		case chat.LinkTypeCell:
+			l.Row, l.Col = IntPtr(link.Row), IntPtr(link.Col)
+		case chat.LinkTypeCommit:
+			l.CommitID = StringPtr(link.CommitID)
+		}
+		result = append(result, l)
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+func TestGame_ResolveCommit(t *testing.T) {
+	game := newTestGame(t)
+	player := &model.Player{ID: "player", DisplayName: "Player"}
+	commit, err := game.CommitChange(masterBranch, fill(0, 0, 4), player)
+	require.NoError(t, err, "commit")
+	commitID := commit.Hash.String()
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "FullID",
+			prefix:   commitID,
+			expected: commitID,
+			ok:       true,
+		},
+		{
+			name:     "AbbreviatedID",
+			prefix:   commitID[:abbreviatedCommitIDLength],
+			expected: commitID,
+			ok:       true,
+		},
+		{
+			name:     "LongerAbbreviatedID",
+			prefix:   commitID[:12],
+			expected: commitID,
+			ok:       true,
+		},
+		{
+			name:   "TooShort",
+			prefix: commitID[:abbreviatedCommitIDLength-1],
+		},
+		{
+			name:   "Unknown",
+			prefix: "0000000",
+		},
+		{
+			name:   "UnknownFullID",
+			prefix: "0000000000000000000000000000000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			actual, ok := game.resolveCommit(tt.prefix)
+
+			// Assert
+			r.Equal(tt.ok, ok, "ok")
+			if tt.ok {
+				r.Equal(tt.expected, actual, "commit")
+			}
+		})
+	}
+}
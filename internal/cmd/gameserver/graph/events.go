@@ -0,0 +1,277 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// Topics of the event bus of a game
+const (
+	branchEventsTopic = "branches"
+	playersTopic      = "players"
+	proposalsTopic    = "proposals"
+)
+
+func commitsTopic(branchID string) string {
+	return "commits/" + branchID
+}
+
+// messagesTopic is the topic of the chat of the branch, or of the whole game if branchID is empty.
+func messagesTopic(branchID string) string {
+	return "messages/" + branchID
+}
+
+func presenceTopic(branchID string) string {
+	return "presence/" + branchID
+}
+
+// NotifyObservers publishes the commit added to the branch.
+func (g *Game) NotifyObservers(branchID string, commit *model.Commit) {
+	g.events.Publish(commitsTopic(branchID), commit)
+}
+
+// NotifyBranchEvent lets the subscribers know a branch was created, moved or deleted.
+func (g *Game) NotifyBranchEvent(event *model.BranchEvent) {
+	event.Timestamp = time.Now()
+	g.events.Publish(branchEventsTopic, event)
+}
+
+// CommitsSince returns the commits of the branch made after the given commit, every parent before its children.
+// The commits merged into the branch are included too.
+func (g *Game) CommitsSince(branchID, sinceCommitID string) ([]*model.Commit, error) {
+	ref, err := g.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+	if err != nil {
+		return nil, gqlerrors.ErrBranchNotFound(branchID)
+	}
+	since, err := g.repo.CommitObject(plumbing.NewHash(sinceCommitID))
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(sinceCommitID)
+	}
+
+	// Skip the commits the subscriber already has
+	known := make(map[plumbing.Hash]struct{})
+	commits, err := g.repo.Log(&git.LogOptions{From: since.Hash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commits before %s: %w", sinceCommitID, err)
+	}
+	err = commits.ForEach(func(c *object.Commit) error {
+		known[c.Hash] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the commits before %s: %w", sinceCommitID, err)
+	}
+
+	// Walk the parents of a commit before the commit itself
+	tip, err := g.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit %s: %w", ref.Hash().String(), err)
+	}
+	result := make([]*model.Commit, 0)
+	var visit func(c *object.Commit) error
+	visit = func(c *object.Commit) error {
+		if _, ok := known[c.Hash]; ok {
+			return nil
+		}
+		known[c.Hash] = struct{}{}
+
+		err := c.Parents().ForEach(visit)
+		if err != nil {
+			return err
+		}
+		commit, err := g.ConvertCommit(c)
+		if err != nil {
+			return fmt.Errorf("failed to convert commit: %w", err)
+		}
+		result = append(result, commit)
+		return nil
+	}
+	err = visit(tip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the commits of branch %s: %w", branchID, err)
+	}
+
+	// The given commit must be an ancestor of the tip, otherwise the whole history would be replayed
+	isAncestor, err := since.IsAncestor(tip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check the ancestors of branch %s: %w", branchID, err)
+	}
+	if !isAncestor && since.Hash != tip.Hash {
+		return nil, gqlerrors.ErrCommitNotOnBranch(sinceCommitID, branchID)
+	}
+
+	return result, nil
+}
+
+// replay are the past events sent to a subscriber before the live ones.
+type replay struct {
+	// events are read right after subscribing, so that no event published in between is missed
+	events func() ([]interface{}, error)
+
+	// id identifies an event, the live events already replayed are skipped
+	id func(event interface{}) string
+}
+
+// commitsReplay replays the commits of the branch made after the given commit.
+func (g *Game) commitsReplay(branchID, sinceCommitID string) *replay {
+	return &replay{
+		events: func() ([]interface{}, error) {
+			commits, err := g.CommitsSince(branchID, sinceCommitID)
+			if err != nil {
+				return nil, err
+			}
+			events := make([]interface{}, 0, len(commits))
+			for _, commit := range commits {
+				events = append(events, commit)
+			}
+			return events, nil
+		},
+		id: func(event interface{}) string {
+			return event.(*model.Commit).ID
+		},
+	}
+}
+
+// subscribe passes the replayed events, if any, then the events of the topic to send until ctx is done or the
+// subscription ends, then calls onDone. A subscriber disconnected for falling behind is sent a RESYNC_REQUIRED error
+// through SubscriptionErrors before its subscription completes, and has to refetch what it follows.
+func (g *Game) subscribe(ctx context.Context, topic string, r *replay, send func(event interface{}, done <-chan struct{}), onDone func()) error {
+	sub := g.events.Subscribe(topic)
+
+	var past []interface{}
+	replayed := make(map[string]struct{})
+	if r != nil {
+		var err error
+		past, err = r.events()
+		if err != nil {
+			sub.Close()
+			return err
+		}
+		for _, event := range past {
+			replayed[r.id(event)] = struct{}{}
+		}
+	}
+
+	go func() {
+		defer onDone()
+		defer sub.Close()
+
+		for _, event := range past {
+			send(event, ctx.Done())
+		}
+
+		for {
+			select {
+			case event, ok := <-sub.Events():
+				if !ok {
+					if errors.Is(sub.Err(), eventbus.ErrSlowConsumer) {
+						g.Warn("Disconnected a slow subscriber.", zap.String("topic", topic))
+						setSubscriptionError(ctx, gqlerrors.ErrResyncRequired())
+					}
+					return
+				}
+				if r != nil {
+					if _, ok := replayed[r.id(event)]; ok {
+						continue
+					}
+				}
+				send(event, ctx.Done())
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+type subscriptionErrorKey struct{}
+
+// subscriptionError is the error ending the subscription of an operation, sent once to its client.
+type subscriptionError struct {
+	err error
+	mu  sync.Mutex
+}
+
+func setSubscriptionError(ctx context.Context, err error) {
+	holder, ok := ctx.Value(subscriptionErrorKey{}).(*subscriptionError)
+	if !ok {
+		return
+	}
+
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+	holder.err = err
+}
+
+// take returns the error and forgets it, so that the next response completes the subscription.
+func (s *subscriptionError) take() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := s.err
+	s.err = nil
+	return err
+}
+
+// SubscriptionErrors is the handler extension sending the error that ended a subscription before completing it,
+// like the resync signal of a subscriber disconnected for falling behind. Otherwise the errors of a subscription are
+// only sent when it starts.
+type SubscriptionErrors struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+} = SubscriptionErrors{}
+
+func (SubscriptionErrors) ExtensionName() string {
+	return "SubscriptionErrors"
+}
+
+func (SubscriptionErrors) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (SubscriptionErrors) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, subscriptionErrorKey{}, &subscriptionError{}))
+}
+
+func (SubscriptionErrors) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp != nil {
+		return resp
+	}
+
+	// The subscription completes, send the error that ended it first
+	holder, ok := ctx.Value(subscriptionErrorKey{}).(*subscriptionError)
+	if !ok {
+		return nil
+	}
+	err := holder.take()
+	if err == nil {
+		return nil
+	}
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		gqlErr = gqlerror.WrapPath(nil, err)
+	}
+
+	return &graphql.Response{Errors: gqlerror.List{gqlErr}}
+}
@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/merge"
+)
+
+func TestSubscriptionResolver_CommitAdded_SlowSubscriber_SendResyncError(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, a subscriber to master with room for a single pending commit, served like the handler does
+	cfg, err := NewResolver(ResolverOptions{
+		Puzzle: newTestPuzzle(t),
+		Signer: testSigner,
+		Events: eventbus.Options{QueueSize: 1, Policy: eventbus.PolicyDisconnect},
+	})
+	r.NoError(err, "resolver")
+	res := cfg.Resolvers.(*Resolver)
+	defer res.Close()
+	game, err := res.getGame(DefaultGameID)
+	r.NoError(err, "game")
+
+	ext := SubscriptionErrors{}
+	var opCtx context.Context
+	responses := ext.InterceptOperation(context.Background(), func(ctx context.Context) graphql.ResponseHandler {
+		opCtx = ctx
+		var commits <-chan *model.Commit
+		commits, err = (&subscriptionResolver{res}).CommitAdded(ctx, DefaultGameID, masterBranch, nil)
+		return func(ctx context.Context) *graphql.Response {
+			return ext.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+				commit, ok := <-commits
+				if !ok {
+					return nil
+				}
+				return &graphql.Response{Data: []byte(strconv.Quote(commit.ID))}
+			})
+		}
+	})
+	r.NoError(err, "subscribe")
+
+	// Act, publish more commits than the subscriber can hold before reading any
+	for i := 0; i < 3; i++ {
+		game.NotifyObservers(masterBranch, &model.Commit{ID: strconv.Itoa(i)})
+	}
+	var sent []*graphql.Response
+	for resp := responses(opCtx); resp != nil; resp = responses(opCtx) {
+		sent = append(sent, resp)
+	}
+
+	// Assert, the commits read before falling behind, then the error asking to resync before completing
+	r.NotEmpty(sent, "responses")
+	last := sent[len(sent)-1]
+	r.Nil(last.Data, "data of the error")
+	r.Len(last.Errors, 1, "errors")
+	r.Equal("RESYNC_REQUIRED", last.Errors[0].Extensions["code"], "error code")
+	for _, resp := range sent[:len(sent)-1] {
+		r.Empty(resp.Errors, "errors of the commits")
+		r.NotNil(resp.Data, "commit")
+	}
+}
+
+func TestSubscriptionErrors_SubscriptionCancelled_CompleteWithoutError(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	res, _ := newTestResolver(t)
+	ext := SubscriptionErrors{}
+	ctx, cancel := context.WithCancel(context.Background())
+	var opCtx context.Context
+	var err error
+	responses := ext.InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+		opCtx = ctx
+		var commits <-chan *model.Commit
+		commits, err = (&subscriptionResolver{res}).CommitAdded(ctx, DefaultGameID, masterBranch, nil)
+		return func(ctx context.Context) *graphql.Response {
+			return ext.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+				if _, ok := <-commits; !ok {
+					return nil
+				}
+				return &graphql.Response{}
+			})
+		}
+	})
+	r.NoError(err, "subscribe")
+
+	// Act
+	cancel()
+	resp := responses(opCtx)
+
+	// Assert
+	r.Nil(resp, "completed")
+}
+
+func TestGame_CommitsSince(t *testing.T) {
+	tests := []struct {
+		name string
+		// arrange makes the commits, and returns the commit the subscriber knows and the commits it missed
+		arrange func(t *testing.T, game *Game) (string, []string)
+		err     func(sinceCommitID string) error
+	}{
+		{
+			name: "UpToDate",
+			arrange: func(t *testing.T, game *Game) (string, []string) {
+				c := commit(t, game, "alice", alice, fill(0, 0, 5))
+				return c.Hash.String(), []string{}
+			},
+		},
+		{
+			name: "Behind",
+			arrange: func(t *testing.T, game *Game) (string, []string) {
+				since := tip(t, game, "alice")
+				c1 := commit(t, game, "alice", alice, fill(0, 0, 5))
+				c2 := commit(t, game, "alice", alice, fill(0, 2, 6))
+				return since.String(), []string{c1.Hash.String(), c2.Hash.String()}
+			},
+		},
+		{
+			name: "MergedCommits",
+			arrange: func(t *testing.T, game *Game) (string, []string) {
+				since := tip(t, game, "alice")
+				addBranch(t, game, "bob", masterBranch)
+				c1 := commit(t, game, "alice", alice, fill(0, 0, 5))
+				c2 := commit(t, game, "bob", bob, fill(0, 4, 4))
+				payload, err := game.MergeBranch("alice", "bob", merge.Replay{}, alice, "")
+				require.NoError(t, err, "merge")
+				return since.String(), []string{c1.Hash.String(), c2.Hash.String(), payload.Commit.ID}
+			},
+		},
+		{
+			name: "CommitNotOnBranch",
+			arrange: func(t *testing.T, game *Game) (string, []string) {
+				addBranch(t, game, "bob", masterBranch)
+				c := commit(t, game, "bob", bob, fill(0, 4, 4))
+				return c.Hash.String(), nil
+			},
+			err: func(sinceCommitID string) error {
+				return gqlerrors.ErrCommitNotOnBranch(sinceCommitID, "alice")
+			},
+		},
+		{
+			name: "UnknownCommit",
+			arrange: func(t *testing.T, game *Game) (string, []string) {
+				return plumbing.ZeroHash.String(), nil
+			},
+			err: func(sinceCommitID string) error {
+				return gqlerrors.ErrCommitNotFound(sinceCommitID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			addBranch(t, game, "alice", masterBranch)
+			sinceCommitID, expected := tt.arrange(t, game)
+
+			// Act
+			commits, err := game.CommitsSince("alice", sinceCommitID)
+
+			// Assert
+			if tt.err != nil {
+				r.Equal(tt.err(sinceCommitID), err, "err")
+				return
+			}
+			r.NoError(err, "commits")
+			ids := make([]string, 0, len(commits))
+			for _, c := range commits {
+				ids = append(ids, c.ID)
+			}
+			r.Equal(expected, ids, "commits")
+		})
+	}
+}
+
+func TestGame_Subscribe_CommitPublishedDuringReplay_SendOnce(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, a commit missed by the subscriber, then another made while its missed commits are read
+	game := newTestGame(t)
+	since := tip(t, game, masterBranch)
+	missed := commit(t, game, masterBranch, alice, fill(0, 0, 5))
+	var during *object.Commit
+	replay := game.commitsReplay(masterBranch, since.String())
+	readMissed := replay.events
+	replay.events = func() ([]interface{}, error) {
+		during = commit(t, game, masterBranch, alice, fill(0, 2, 6))
+		publishCommit(t, game, masterBranch, during)
+		return readMissed()
+	}
+
+	// Act
+	commits := make(chan *model.Commit, 8)
+	err := game.subscribe(context.Background(), commitsTopic(masterBranch), replay, func(event interface{}, done <-chan struct{}) {
+		commits <- event.(*model.Commit)
+	}, func() {
+		close(commits)
+	})
+	r.NoError(err, "subscribe")
+	after := commit(t, game, masterBranch, alice, fill(0, 4, 4))
+	publishCommit(t, game, masterBranch, after)
+
+	// Assert, the live commit already replayed is skipped
+	for _, expected := range []*object.Commit{missed, during, after} {
+		c := <-commits
+		r.Equal(expected.Hash.String(), c.ID, "commit")
+	}
+	r.NoError(game.Close(), "close")
+	_, ok := <-commits
+	r.False(ok, "no more commits")
+}
+
+func TestSubscriptionResolver_CommitAdded_SinceCommitNotOnBranch_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	res, game := newTestResolver(t)
+	addBranch(t, game, "alice", masterBranch)
+	c := commit(t, game, "alice", alice, fill(0, 0, 5))
+	sinceCommitID := c.Hash.String()
+
+	// Act
+	commits, err := (&subscriptionResolver{res}).CommitAdded(context.Background(), DefaultGameID, masterBranch, &sinceCommitID)
+
+	// Assert
+	r.Equal(gqlerrors.ErrCommitNotOnBranch(sinceCommitID, masterBranch), err, "err")
+	r.Nil(commits, "commits")
+}
+
+// publishCommit lets the subscribers know about the commit on the branch, as the mutations do.
+func publishCommit(t *testing.T, game *Game, branchID string, c *object.Commit) {
+	converted, err := game.ConvertCommit(c)
+	require.NoError(t, err, "convert commit")
+	game.NotifyObservers(branchID, converted)
+}
@@ -0,0 +1,263 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"go.uber.org/zap"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/middleware"
+	"github.com/nhan-ng/sudoku/internal/puzzle"
+)
+
+// Game is a single Sudoku game hosted by the server, backed by its own git repository.
+type Game struct {
+	ID string
+
+	sudoku *model.Sudoku
+
+	// commands are run one at a time by the goroutine of the game, see do
+	commands  chan func()
+	closing   chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+
+	// state is the latest *snapshot of the game, read without waiting for the goroutine of the game
+	state atomic.Value
+
+	// The fields below are only accessed by the goroutine of the game
+
+	// players are keyed by their ID
+	players     map[string]*model.Player
+	playerNames map[string]struct{}
+
+	// presences are keyed by player ID
+	presences map[string]*presence
+
+	messages []*model.Message
+
+	// merges are the merges waiting for their conflicts to be resolved, keyed by their ID
+	merges map[string]*pendingMerge
+
+	// branches are the owner and the protection of the branches, keyed by branch ID
+	branches map[string]*branchRules
+
+	// proposals are the merge proposals of the game, keyed by their ID
+	proposals map[string]*model.Proposal
+
+	// events carries the commits, branch, player and message events of the game to its subscribers
+	events *eventbus.Bus
+
+	// repo has no worktree, commits are written as objects and branches moved with compare-and-swap.
+	// It is safe for concurrent use, commits to any branch don't need the goroutine of the game.
+	repo *git.Repository
+
+	*zap.Logger
+}
+
+// openGame reopens the game from the storage if it exists, otherwise starts a new one from the puzzle.
+func openGame(storage Storage, gameID string, p *puzzle.Puzzle, events eventbus.Options) (*Game, error) {
+	repo, err := storage.Open(gameID)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = newGame(storage, gameID, p)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to open the repo: %w", err)
+	} else {
+		zap.L().Info("Reopened existing repo.", zap.String("gameId", gameID))
+	}
+	locked, err := newLockedStorer(repo.Storer)
+	if err != nil {
+		return nil, err
+	}
+	repo, err = git.Open(locked, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the repo storage: %w", err)
+	}
+
+	// The fixed cells are the same on every commit, read them from the master branch
+	ref, err := repo.Reference(plumbing.NewBranchReferenceName(masterBranch), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the %s branch: %w", masterBranch, err)
+	}
+	commit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit %s: %w", ref.Hash().String(), err)
+	}
+	board, err := ReadBoard(commit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board: %w", err)
+	}
+
+	metadata, err := readPuzzleMetadata(repo, board)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read puzzle metadata: %w", err)
+	}
+	branches, err := readBranchRules(repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read branch rules: %w", err)
+	}
+
+	// Prepare the sudoku
+	sudoku := &model.Sudoku{
+		GameID:   gameID,
+		BranchID: masterBranch,
+		Board:    board.GetImmutableBoards(),
+		Puzzle:   metadata,
+	}
+
+	game := &Game{
+		ID:          gameID,
+		sudoku:      sudoku,
+		commands:    make(chan func(), commandQueueSize),
+		closing:     make(chan struct{}),
+		stopped:     make(chan struct{}),
+		players:     make(map[string]*model.Player),
+		playerNames: make(map[string]struct{}),
+		repo:        repo,
+		events:      eventbus.New(events),
+		presences:   make(map[string]*presence),
+		merges:      make(map[string]*pendingMerge),
+		branches:    branches,
+		proposals:   make(map[string]*model.Proposal),
+		Logger:      zap.L().With(zap.String("gameId", gameID)),
+	}
+	game.publishSnapshot()
+	go game.run()
+
+	return game, nil
+}
+
+func newGame(storage Storage, gameID string, p *puzzle.Puzzle) (*git.Repository, error) {
+	if p == nil {
+		return nil, fmt.Errorf("no puzzle to start a new game from")
+	}
+
+	// Initialize the repo
+	repo, err := storage.Init(gameID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize the repo: %w", err)
+	}
+	err = initGame(repo, gameID, p)
+	if err != nil {
+		// A repo without master wouldn't reopen, don't leave it behind
+		removeErr := storage.Remove(gameID)
+		if removeErr != nil {
+			zap.L().Warn("Failed to remove the repo of the game.", zap.String("gameId", gameID), zap.Error(removeErr))
+		}
+		return nil, err
+	}
+
+	return repo, nil
+}
+
+// initGame writes the puzzle to the new repo, as the first commit of the master branch.
+func initGame(repo *git.Repository, gameID string, p *puzzle.Puzzle) error {
+	err := writePuzzleMetadata(repo, p)
+	if err != nil {
+		return err
+	}
+
+	// Commit the puzzle as the first commit of the master branch
+	sig := &object.Signature{
+		Name:  "Game Master",
+		Email: "gm@gitdoku.io",
+		When:  time.Now(),
+	}
+	commitID, err := writeCommit(repo.Storer, p.Board, fmt.Sprintf("%s", model.CommitTypeInitial), sig)
+	if err != nil {
+		return fmt.Errorf("failed to create initial commit: %w", err)
+	}
+	err = repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(masterBranch), commitID))
+	if err != nil {
+		return fmt.Errorf("failed to create the %s branch: %w", masterBranch, err)
+	}
+
+	commits, err := repo.CommitObjects()
+	if err != nil {
+		return fmt.Errorf("failed to create initial commit: %w", err)
+	}
+
+	err = commits.ForEach(func(commit *object.Commit) error {
+		file, err := commit.File(gameFile)
+		if err != nil {
+			return err
+		}
+		content, err := file.Contents()
+		if err != nil {
+			return err
+		}
+
+		zap.L().Info("Commit: ", zap.String("commitId", commit.Hash.String()), zap.String("content", content))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create initial commit: %w", err)
+	}
+
+	zap.L().Info("Initialized origin repo.", zap.String("gameId", gameID), zap.String("commitId", commitID.String()))
+
+	return nil
+}
+
+func (g *Game) Close() error {
+	g.closeOnce.Do(func() {
+		close(g.closing)
+	})
+	<-g.stopped
+	g.events.Close()
+
+	// Release the file handles of filesystem-backed repos, their data is kept for the next start
+	if closer, ok := g.repo.Storer.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+func (g *Game) getPlayer(ctx context.Context) (*model.Player, error) {
+	session, err := middleware.SessionForContext(ctx)
+	if err != nil || session.GameID != g.ID {
+		return nil, gqlerrors.ErrNotJoined(g.ID)
+	}
+
+	player, ok := g.snapshot().players[session.PlayerID]
+	if !ok {
+		return nil, gqlerrors.ErrNotJoined(g.ID)
+	}
+
+	return player, nil
+}
+
+// ConvertBranch converts the reference to a branch of this game.
+func (g *Game) ConvertBranch(ref *plumbing.Reference) *model.Branch {
+	branch := ConvertBranch(ref)
+	if branch != nil {
+		branch.GameID = g.ID
+	}
+
+	return branch
+}
+
+// ConvertCommit converts the commit to a commit of this game.
+func (g *Game) ConvertCommit(commit *object.Commit) (*model.Commit, error) {
+	result, err := ConvertCommit(commit)
+	if result != nil {
+		result.GameID = g.ID
+	}
+
+	return result, err
+}
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/engine"
+	"github.com/nhan-ng/sudoku/internal/puzzle"
+)
+
+// testPuzzle has an empty first row but for (0, 1), (0, 3), (0, 5) and (0, 6)
+const testPuzzle = `070308100
+040100000
+000090082
+001000500
+000000230
+000283070
+094005000
+526000700
+000000009`
+
+func newTestPuzzle(t *testing.T) *puzzle.Puzzle {
+	p, err := puzzle.FromString(testPuzzle)
+	require.NoError(t, err, "puzzle")
+
+	return p
+}
+
+// newTestGame starts a new game in memory, closed at the end of the test.
+func newTestGame(t *testing.T) *Game {
+	game, err := openGame(NewMemoryStorage(), "game", newTestPuzzle(t), eventbus.Options{})
+	require.NoError(t, err, "open game")
+	t.Cleanup(func() {
+		_ = game.Close()
+	})
+
+	return game
+}
+
+// fill fills the cell of the branch with the value, as the addCommit mutation does.
+func fill(row, col, val int) BoardChange {
+	return func(board engine.Board) (string, error) {
+		board[row][col].Value = val
+		return fmt.Sprintf("%s %d %d %d", model.CommitTypeAddFill, row, col, val), nil
+	}
+}
+
+// tipBoard returns the board of the tip of the branch.
+func tipBoard(t *testing.T, game *Game, branchID string) engine.Board {
+	ref, err := game.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+	require.NoError(t, err, "branch %s", branchID)
+	commit, err := game.repo.CommitObject(ref.Hash())
+	require.NoError(t, err, "tip of %s", branchID)
+	board, err := ReadBoard(commit)
+	require.NoError(t, err, "board of %s", branchID)
+
+	return board
+}
+
+func TestOpenGame_CloseAndReopen_KeepGame(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage Storage
+	}{
+		{
+			name:    "Memory",
+			storage: NewMemoryStorage(),
+		},
+		{
+			name:    "Filesystem",
+			storage: FilesystemStorage{Root: t.TempDir()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game, err := openGame(tt.storage, "game", newTestPuzzle(t), eventbus.Options{})
+			r.NoError(err, "open")
+			player := &model.Player{ID: "player", DisplayName: "Player"}
+			commit, err := game.CommitChange(masterBranch, fill(0, 0, 4), player)
+			r.NoError(err, "commit")
+			r.NoError(game.Close(), "close")
+
+			// Act, without a puzzle to start a new game from
+			reopened, err := openGame(tt.storage, "game", nil, eventbus.Options{})
+
+			// Assert
+			r.NoError(err, "reopen")
+			defer reopened.Close()
+			ref, err := reopened.repo.Reference(plumbing.NewBranchReferenceName(masterBranch), false)
+			r.NoError(err, "master")
+			r.Equal(commit.Hash, ref.Hash(), "tip")
+			r.Equal(4, tipBoard(t, reopened, masterBranch)[0][0].Value, "cell")
+			r.Equal(game.sudoku.Board, reopened.sudoku.Board, "fixed cells")
+			r.Equal(game.sudoku.Puzzle, reopened.sudoku.Puzzle, "puzzle")
+			commitID, ok := reopened.resolveCommit(commit.Hash.String()[:abbreviatedCommitIDLength])
+			r.True(ok, "indexed commit")
+			r.Equal(commit.Hash.String(), commitID, "indexed commit")
+			gameIDs, err := tt.storage.List()
+			r.NoError(err, "list")
+			r.Equal([]string{"game"}, gameIDs, "games")
+		})
+	}
+}
+
+func TestOpenGame_UnknownGameWithoutPuzzle_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	// Act
+	_, err := openGame(NewMemoryStorage(), "game", nil, eventbus.Options{})
+
+	// Assert
+	r.Error(err, "err")
+}
+
+// brokenConfigStorage creates repos that fail to keep the metadata of their puzzle, once initialized.
+type brokenConfigStorage struct {
+	*MemoryStorage
+}
+
+func (s brokenConfigStorage) Init(gameID string) (*git.Repository, error) {
+	repo, err := s.MemoryStorage.Init(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return git.Open(brokenConfigStorer{repo.Storer}, nil)
+}
+
+type brokenConfigStorer struct {
+	storage.Storer
+}
+
+func (s brokenConfigStorer) SetConfig(cfg *config.Config) error {
+	if cfg.Raw.HasSection(puzzleSection) {
+		return errors.New("disk full")
+	}
+
+	return s.Storer.SetConfig(cfg)
+}
+
+func TestOpenGame_FailAfterInit_RemoveRepo(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	gameStorage := brokenConfigStorage{NewMemoryStorage()}
+
+	// Act
+	_, err := openGame(gameStorage, "game", newTestPuzzle(t), eventbus.Options{})
+
+	// Assert
+	r.Error(err, "err")
+	gameIDs, err := gameStorage.List()
+	r.NoError(err, "list")
+	r.Empty(gameIDs, "games")
+}
@@ -25,6 +25,82 @@ func ErrBranchNotFound(id string) error {
 	return gqlerror.Errorf("branch with id '%s' not found", id)
 }
 
+func ErrCommitNotOnBranch(commitID, branchID string) error {
+	return gqlerror.Errorf("commit '%s' is not on branch '%s'", commitID, branchID)
+}
+
+func ErrBranchChanged(branchID string) error {
+	return gqlerror.Errorf("branch '%s' was changed concurrently, please try again", branchID)
+}
+
+func ErrInvalidBranchName(name string) error {
+	return gqlerror.Errorf("invalid branch name '%s'", name)
+}
+
+func ErrDefaultBranch(branchID string) error {
+	return gqlerror.Errorf("branch '%s' is the default branch, it can't be renamed or deleted", branchID)
+}
+
+func ErrBranchOwnerOnly(branchID string) error {
+	return gqlerror.Errorf("only the owner of branch '%s' can change it", branchID)
+}
+
+func ErrBranchNoForceReset(branchID string) error {
+	return gqlerror.Errorf("branch '%s' is protected against dropping its commits", branchID)
+}
+
+func ErrBranchRequiresApproval(branchID string) error {
+	return gqlerror.Errorf("merges into branch '%s' require an approved proposal", branchID)
+}
+
+func ErrInvalidRequiredApprovals() error {
+	return gqlerror.Errorf("required approvals must be at least 1")
+}
+
+func ErrProposalNotFound(id string) error {
+	return gqlerror.Errorf("proposal with id '%s' not found", id)
+}
+
+func ErrProposalNotOpen(id string) error {
+	return gqlerror.Errorf("proposal '%s' is not open anymore", id)
+}
+
+func ErrOwnProposal(id string) error {
+	return gqlerror.Errorf("players can't vote on their own proposal '%s'", id)
+}
+
+func ErrSameBranch(branchID string) error {
+	return gqlerror.Errorf("branch '%s' can't be merged into itself", branchID)
+}
+
+func ErrBranchHasProposals(branchID, proposalID string) error {
+	return gqlerror.Errorf("branch '%s' has proposal '%s' waiting to be merged or rejected", branchID, proposalID)
+}
+
+func ErrInvalidProposalTitle(maxLength int) error {
+	return gqlerror.Errorf("proposal title must have between 1 and %d characters", maxLength)
+}
+
+func ErrMergeNotFound(id string) error {
+	return gqlerror.Errorf("merge with id '%s' not found", id)
+}
+
+func ErrMergeConflictUnresolved(row, col int) error {
+	return gqlerror.Errorf("conflict on cell [%d][%d] is not resolved", row, col)
+}
+
+func ErrNotMergeConflict(row, col int) error {
+	return gqlerror.Errorf("cell [%d][%d] has no merge conflict", row, col)
+}
+
+func ErrNothingToUndo(branchID string) error {
+	return gqlerror.Errorf("nothing to undo on branch '%s'", branchID)
+}
+
+func ErrNothingToRedo(branchID string) error {
+	return gqlerror.Errorf("nothing to redo on branch '%s'", branchID)
+}
+
 func ErrBranchObserverAlreadyExists(observerID, BranchID string) error {
 	return gqlerror.Errorf("observer '%s' for branch '%s' already exists", observerID, BranchID)
 }
@@ -36,3 +112,46 @@ func ErrBranchAlreadyExists(BranchID string) error {
 func ErrInvalidInputCommitType(commitType model.CommitType) error {
 	return gqlerror.Errorf("invalid commit type '%s'", commitType)
 }
+
+func ErrGameNotFound(id string) error {
+	return gqlerror.Errorf("game with id '%s' not found", id)
+}
+
+func ErrTooManyGames(maxGames int) error {
+	return gqlerror.Errorf("the server hosts %d games already, no more games can be created", maxGames)
+}
+
+func ErrInvalidPuzzle(err error) error {
+	return gqlerror.Errorf("invalid puzzle: %s", err)
+}
+
+func ErrPuzzleNotFound(name string) error {
+	return gqlerror.Errorf("puzzle '%s' not found", name)
+}
+
+func ErrNotJoined(gameID string) error {
+	return gqlerror.Errorf("player has not joined game '%s'", gameID)
+}
+
+func ErrInvalidDisplayName(maxLength int) error {
+	return gqlerror.Errorf("display name must have between 1 and %d characters", maxLength)
+}
+
+func ErrDisplayNameTaken(name string) error {
+	return gqlerror.Errorf("display name '%s' is already taken", name)
+}
+
+func ErrInvalidColor(color string) error {
+	return gqlerror.Errorf("invalid color '%s', expected #rrggbb", color)
+}
+
+func ErrInvalidMessage(maxLength int) error {
+	return gqlerror.Errorf("message must have between 1 and %d characters", maxLength)
+}
+
+func ErrResyncRequired() error {
+	return &gqlerror.Error{
+		Message:    "subscription fell behind the events and was closed, refetch and subscribe again",
+		Extensions: map[string]interface{}{"code": "RESYNC_REQUIRED"},
+	}
+}
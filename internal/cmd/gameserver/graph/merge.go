@@ -0,0 +1,656 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/google/uuid"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/merge"
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+// pendingMerge is a merge waiting for the players to resolve its conflicts before the merge commit is written.
+type pendingMerge struct {
+	*model.PendingMerge
+
+	// sourceRef is the tip of the source branch the merge started from, the merge fails if the branch moved since
+	sourceRef *plumbing.Reference
+	// targetID is the tip of the target branch merged, the second parent of the merge commit
+	targetID plumbing.Hash
+	// proposalID is the proposal approving the merge, empty for a merge without proposal
+	proposalID string
+
+	// board is the merged board, the conflicting cells are taken from one side once resolved
+	board       engine.Board
+	sourceBoard engine.Board
+	targetBoard engine.Board
+}
+
+// mergeBase is the common ancestor of the branches merged, a virtual one when they have more than 1 merge base.
+type mergeBase struct {
+	board engine.Board
+
+	// commits are reachable from the merge base, they are part of both branches and never replayed
+	commits map[plumbing.Hash]bool
+}
+
+// mergeRefs are the tips of the branches of a merge and their merge bases.
+type mergeRefs struct {
+	source *plumbing.Reference
+	target *plumbing.Reference
+	bases  []*object.Commit
+}
+
+// FastForward tells whether the source branch is an ancestor of the target branch, and only has to move to its tip.
+func (m *mergeRefs) FastForward() bool {
+	return len(m.bases) == 1 && m.bases[0].Hash == m.source.Hash()
+}
+
+// cellChanges are the last commits changing each cell of a branch since the merge base.
+type cellChanges map[[2]int]*object.Commit
+
+// findMergeBases reads the tips of both branches and finds their merge bases.
+func (g *Game) findMergeBases(sourceBranchID, targetBranchID string) (*mergeRefs, error) {
+	sourceRef, err := g.repo.Reference(plumbing.NewBranchReferenceName(sourceBranchID), false)
+	if err != nil {
+		return nil, gqlerrors.ErrBranchNotFound(sourceBranchID)
+	}
+	sourceCommit, err := g.repo.CommitObject(sourceRef.Hash())
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(sourceRef.Hash().String())
+	}
+
+	targetRef, err := g.repo.Reference(plumbing.NewBranchReferenceName(targetBranchID), false)
+	if err != nil {
+		return nil, gqlerrors.ErrBranchNotFound(targetBranchID)
+	}
+	targetCommit, err := g.repo.CommitObject(targetRef.Hash())
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(targetRef.Hash().String())
+	}
+
+	bases, err := sourceCommit.MergeBase(targetCommit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge base: %w", err)
+	}
+
+	return &mergeRefs{source: sourceRef, target: targetRef, bases: bases}, nil
+}
+
+// Merge merges the target branch into the source branch with the strategy. The conflicts the strategy leaves, with
+// the last commit of each side changing the cell, are resolved by the players.
+func (g *Game) Merge(sourceRef, targetRef *plumbing.Reference, bases []*object.Commit, strategy merge.Strategy) (*pendingMerge, error) {
+	source, err := g.repo.CommitObject(sourceRef.Hash())
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(sourceRef.Hash().String())
+	}
+	target, err := g.repo.CommitObject(targetRef.Hash())
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(targetRef.Hash().String())
+	}
+
+	base, err := g.virtualBase(bases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge base: %w", err)
+	}
+
+	sourceCommits, err := commitsExcept(source, base.commits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commits from source branch: %w", err)
+	}
+	targetCommits, err := commitsExcept(target, base.commits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commits from target branch: %w", err)
+	}
+
+	sourceBoard, err := ReadBoard(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source board: %w", err)
+	}
+	targetBoard, err := ReadBoard(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target board: %w", err)
+	}
+	result, err := strategy.Merge(merge.Input{
+		Base:   base.board,
+		Source: merge.Branch{Board: sourceBoard, Changes: commitChanges(sourceCommits)},
+		Target: merge.Branch{Board: targetBoard, Changes: commitChanges(targetCommits)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge branches: %w", err)
+	}
+
+	// Point the players to the last commit of each side changing the cells in conflict
+	sourceChanges, err := changedCells(sourceCommits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the changes of source branch: %w", err)
+	}
+	targetChanges, err := changedCells(targetCommits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the changes of target branch: %w", err)
+	}
+
+	conflicts := make([]*model.MergeConflict, 0, len(result.Conflicts))
+	for _, conflict := range result.Conflicts {
+		row, col := conflict.Row, conflict.Col
+		conflicts = append(conflicts, &model.MergeConflict{
+			Row:    row,
+			Col:    col,
+			Type:   model.MergeConflictType(conflict.Type),
+			Source: convertConflictSide(sourceBoard[row][col], sourceChanges[[2]int{row, col}]),
+			Target: convertConflictSide(targetBoard[row][col], targetChanges[[2]int{row, col}]),
+		})
+	}
+
+	return &pendingMerge{
+		PendingMerge: &model.PendingMerge{
+			ID:             uuid.NewString(),
+			SourceBranchID: sourceRef.Name().Short(),
+			TargetBranchID: targetRef.Name().Short(),
+			Conflicts:      conflicts,
+		},
+		sourceRef:   sourceRef,
+		targetID:    targetRef.Hash(),
+		board:       result.Board,
+		sourceBoard: sourceBoard,
+		targetBoard: targetBoard,
+	}, nil
+}
+
+// PreviewMerge computes the merge of the branches like MergeBranch, without writing any object or moving any branch.
+// The changes are the cells of the source branch the merge would change.
+func (g *Game) PreviewMerge(sourceBranchID, targetBranchID string, strategy merge.Strategy) (*model.MergePreview, error) {
+	refs, err := g.findMergeBases(sourceBranchID, targetBranchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceBoard, board engine.Board
+	conflicts := make([]*model.MergeConflict, 0)
+	if refs.FastForward() {
+		source, err := g.repo.CommitObject(refs.source.Hash())
+		if err != nil {
+			return nil, gqlerrors.ErrCommitNotFound(refs.source.Hash().String())
+		}
+		sourceBoard, err = ReadBoard(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source board: %w", err)
+		}
+		target, err := g.repo.CommitObject(refs.target.Hash())
+		if err != nil {
+			return nil, gqlerrors.ErrCommitNotFound(refs.target.Hash().String())
+		}
+		board, err = ReadBoard(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get target board: %w", err)
+		}
+	} else {
+		pending, err := g.Merge(refs.source, refs.target, refs.bases, strategy)
+		if err != nil {
+			return nil, err
+		}
+		sourceBoard, board, conflicts = pending.sourceBoard, pending.board, pending.Conflicts
+	}
+
+	changes := make([]*model.CellChange, 0)
+	for row := range board {
+		for col := range board[row] {
+			if merge.CellEqual(sourceBoard[row][col], board[row][col]) {
+				continue
+			}
+
+			changes = append(changes, &model.CellChange{
+				Row:    row,
+				Col:    col,
+				Before: convertCellPtr(sourceBoard[row][col]),
+				After:  convertCellPtr(board[row][col]),
+			})
+		}
+	}
+
+	return &model.MergePreview{
+		FastForward: refs.FastForward(),
+		Blob:        ConvertBlob(board),
+		Changes:     changes,
+		Conflicts:   conflicts,
+	}, nil
+}
+
+// Resolve takes every conflicting cell from the side chosen for it, and returns the merged board.
+func (m *pendingMerge) Resolve(resolutions []*model.MergeResolutionInput) (engine.Board, error) {
+	sides := make(map[[2]int]model.MergeSide, len(resolutions))
+	for _, resolution := range resolutions {
+		sides[[2]int{resolution.Row, resolution.Col}] = resolution.Side
+	}
+
+	// Keep the pending merge as is if the resolutions are rejected
+	board := m.board.Copy()
+	for _, conflict := range m.Conflicts {
+		cell := [2]int{conflict.Row, conflict.Col}
+		side, ok := sides[cell]
+		if !ok {
+			return nil, gqlerrors.ErrMergeConflictUnresolved(conflict.Row, conflict.Col)
+		}
+		delete(sides, cell)
+
+		switch side {
+		case model.MergeSideSource:
+			board[conflict.Row][conflict.Col] = m.sourceBoard[conflict.Row][conflict.Col]
+		case model.MergeSideTarget:
+			board[conflict.Row][conflict.Col] = m.targetBoard[conflict.Row][conflict.Col]
+		}
+	}
+
+	// Every resolution must match a conflict, a typo would otherwise go unnoticed
+	for cell := range sides {
+		return nil, gqlerrors.ErrNotMergeConflict(cell[0], cell[1])
+	}
+
+	return board, nil
+}
+
+// MergeBranch merges the target branch into the source branch, fast-forwarding the source branch when possible.
+// A merge with conflicts is kept until resolved. The merge is approved by the proposal, if any, the branches
+// requiring approval like master are only merged into through one.
+func (g *Game) MergeBranch(sourceBranchID, targetBranchID string, strategy merge.Strategy, player *model.Player, proposalID string) (*model.MergeBranchPayload, error) {
+	action := actionMerge
+	if proposalID != "" {
+		action = actionApprovedMerge
+	}
+	err := g.authorize(sourceBranchID, player, action)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := g.findMergeBases(sourceBranchID, targetBranchID)
+	if err != nil {
+		return nil, err
+	}
+
+	if refs.FastForward() {
+		newRef := plumbing.NewHashReference(refs.source.Name(), refs.target.Hash())
+		err = g.repo.Storer.CheckAndSetReference(newRef, refs.source)
+		if errors.Is(err, storage.ErrReferenceHasChanged) {
+			return nil, gqlerrors.ErrBranchChanged(sourceBranchID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fast-forward the source branch: %w", err)
+		}
+		g.NotifyBranchEvent(&model.BranchEvent{
+			Type:           model.BranchEventTypeFastForwarded,
+			BranchID:       sourceBranchID,
+			SourceBranchID: StringPtr(targetBranchID),
+			OldCommitID:    StringPtr(refs.source.Hash().String()),
+			NewCommitID:    StringPtr(newRef.Hash().String()),
+			Player:         player,
+		})
+
+		return &model.MergeBranchPayload{
+			SourceBranch: g.ConvertBranch(newRef),
+		}, nil
+	}
+
+	pending, err := g.Merge(refs.source, refs.target, refs.bases, strategy)
+	if err != nil {
+		return nil, err
+	}
+	pending.proposalID = proposalID
+
+	// Let the players pick a side for each conflict before writing the merge commit
+	if len(pending.Conflicts) > 0 {
+		err = g.do(func() error {
+			g.merges[pending.ID] = pending
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.MergeBranchPayload{PendingMerge: pending.PendingMerge}, nil
+	}
+
+	return g.commitMerge(pending, pending.board, player)
+}
+
+// commitMerge writes the merged board as the merge commit of the source branch, with the tips of the source and
+// target branches as parents, so that later merges find the right merge base.
+func (g *Game) commitMerge(m *pendingMerge, board engine.Board, player *model.Player) (*model.MergeBranchPayload, error) {
+	mergeCommit, err := g.CommitBoard(m.sourceRef, board, fmt.Sprintf("MERGE %s %s", m.SourceBranchID, m.TargetBranchID), player, m.targetID)
+	if errors.Is(err, storage.ErrReferenceHasChanged) {
+		return nil, gqlerrors.ErrBranchChanged(m.SourceBranchID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a merge commit: %w", err)
+	}
+	commit, err := g.ConvertCommit(mergeCommit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert commit: %w", err)
+	}
+	g.NotifyObservers(m.SourceBranchID, commit)
+	g.NotifyBranchEvent(&model.BranchEvent{
+		Type:           model.BranchEventTypeMerged,
+		BranchID:       m.SourceBranchID,
+		SourceBranchID: StringPtr(m.TargetBranchID),
+		OldCommitID:    StringPtr(m.sourceRef.Hash().String()),
+		NewCommitID:    StringPtr(mergeCommit.Hash.String()),
+		Player:         player,
+	})
+
+	return &model.MergeBranchPayload{
+		SourceBranch: g.ConvertBranch(plumbing.NewHashReference(m.sourceRef.Name(), mergeCommit.Hash)),
+		Commit:       commit,
+	}, nil
+}
+
+func convertConflictSide(cell engine.Cell, commit *object.Commit) *model.MergeConflictSide {
+	side := &model.MergeConflictSide{
+		Val:   cell.Value,
+		Notes: cell.Notes.AsNumbers(),
+	}
+	if commit != nil {
+		side.CommitID = StringPtr(commit.Hash.String())
+		side.AuthorID = StringPtr(commit.Author.String())
+	}
+
+	return side
+}
+
+// virtualBase returns the merge base of the branches. Branches merged back and forth have more than 1 merge base:
+//
+//	---1---o---A
+//	    \ /
+//	     X
+//	    / \
+//	---2---o---o---B
+//
+// Those are merged into a virtual base like the recursive strategy of git, replaying their commits since their own
+// merge bases, found recursively. Their conflicts are settled by the last commit, as the virtual base is never shown.
+func (g *Game) virtualBase(bases []*object.Commit) (*mergeBase, error) {
+	if len(bases) == 0 {
+		return nil, fmt.Errorf("branches have no common ancestor")
+	}
+	if len(bases) == 1 {
+		board, err := ReadBoard(bases[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get base board: %w", err)
+		}
+		commits, err := commitsExcept(bases[0], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the commits of the merge base: %w", err)
+		}
+
+		return &mergeBase{board: board, commits: commitSet(commits)}, nil
+	}
+
+	// Find the common ancestors of every merge base
+	common := []*object.Commit{bases[0]}
+	for _, base := range bases[1:] {
+		next := make([]*object.Commit, 0)
+		for _, c := range common {
+			ancestors, err := c.MergeBase(base)
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, ancestors...)
+		}
+		var err error
+		common, err = object.Independents(next)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ancestor, err := g.virtualBase(common)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replay the commits of every merge base since their common ancestors
+	commits := make([]*object.Commit, 0)
+	for _, base := range bases {
+		baseCommits, err := commitsExcept(base, ancestor.commits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the commits of the merge base %s: %w", base.Hash.String(), err)
+		}
+		commits = append(commits, baseCommits...)
+	}
+	board, err := replayCommits(ancestor.board, commits)
+	if err != nil {
+		return nil, err
+	}
+
+	known := commitSet(commits)
+	for hash := range ancestor.commits {
+		known[hash] = true
+	}
+
+	return &mergeBase{board: board, commits: known}, nil
+}
+
+// replayCommits applies the commits chronologically on a copy of the board, every commit once.
+func replayCommits(board engine.Board, commits []*object.Commit) (engine.Board, error) {
+	unique := commitSet(nil)
+	sorted := make([]*object.Commit, 0, len(commits))
+	for _, commit := range commits {
+		if unique[commit.Hash] {
+			continue
+		}
+		unique[commit.Hash] = true
+		sorted = append(sorted, commit)
+	}
+	sortByAuthorTime(sorted)
+
+	result := board.Copy()
+	var err error
+	for _, commit := range sorted {
+		result, err = ApplyCommit(result, commit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply commit %s: %w", commit.Hash.String(), err)
+		}
+	}
+
+	return result, nil
+}
+
+// commitsExcept returns the commits reachable from the tip, skipping the known commits and their ancestors.
+func commitsExcept(tip *object.Commit, known map[plumbing.Hash]bool) ([]*object.Commit, error) {
+	// The iterator adds the commits it walks to the seen commits, keep the known commits as is
+	seen := make(map[plumbing.Hash]bool, len(known))
+	for hash := range known {
+		seen[hash] = true
+	}
+
+	commits := make([]*object.Commit, 0)
+	err := object.NewCommitPreorderIter(tip, seen, nil).ForEach(func(c *object.Commit) error {
+		commits = append(commits, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+func commitSet(commits []*object.Commit) map[plumbing.Hash]bool {
+	result := make(map[plumbing.Hash]bool, len(commits))
+	for _, commit := range commits {
+		result[commit.Hash] = true
+	}
+
+	return result
+}
+
+// changedCells finds the last commit changing each cell, comparing the board of every commit with its first parent
+// so that merge commits are accounted for too.
+func changedCells(commits []*object.Commit) (cellChanges, error) {
+	sorted := make([]*object.Commit, len(commits))
+	copy(sorted, commits)
+	sortByAuthorTime(sorted)
+
+	changes := make(cellChanges)
+	for _, commit := range sorted {
+		if commit.NumParents() == 0 {
+			continue
+		}
+		_, cells, err := diffCommit(commit)
+		if err != nil {
+			return nil, err
+		}
+		for _, cell := range cells {
+			changes[cell] = commit
+		}
+	}
+
+	return changes, nil
+}
+
+// diffCommit returns the board of the commit and the cells it changed from its first parent.
+func diffCommit(commit *object.Commit) (engine.Board, [][2]int, error) {
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the parent of commit %s: %w", commit.Hash.String(), err)
+	}
+	before, err := ReadBoard(parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	after, err := ReadBoard(commit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cells := make([][2]int, 0)
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			if !merge.CellEqual(before[row][col], after[row][col]) {
+				cells = append(cells, [2]int{row, col})
+			}
+		}
+	}
+
+	return after, cells, nil
+}
+
+// diffParents returns the board of the commit and the cells it changed from any of its parents.
+func diffParents(commit *object.Commit) (engine.Board, [][2]int, error) {
+	after, err := ReadBoard(commit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changed := make(map[[2]int]bool)
+	cells := make([][2]int, 0)
+	err = commit.Parents().ForEach(func(parent *object.Commit) error {
+		before, err := ReadBoard(parent)
+		if err != nil {
+			return err
+		}
+		for row := 0; row < 9; row++ {
+			for col := 0; col < 9; col++ {
+				cell := [2]int{row, col}
+				if !changed[cell] && !merge.CellEqual(before[row][col], after[row][col]) {
+					changed[cell] = true
+					cells = append(cells, cell)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the parents of commit %s: %w", commit.Hash.String(), err)
+	}
+
+	return after, cells, nil
+}
+
+// commitChanges are the commits as changes replayed by the merge strategies, sorted chronologically as the
+// strategies keep the order of the changes of the same time.
+func commitChanges(commits []*object.Commit) []merge.Change {
+	sorted := make([]*object.Commit, len(commits))
+	copy(sorted, commits)
+	sortByAuthorTime(sorted)
+
+	changes := make([]merge.Change, 0, len(commits))
+	for _, commit := range sorted {
+		commit := commit
+		changes = append(changes, merge.Change{
+			When: commit.Author.When,
+			Apply: func(board engine.Board) (engine.Board, error) {
+				return ApplyCommit(board, commit)
+			},
+		})
+	}
+
+	return changes
+}
+
+// mergeStrategy returns the strategy of the merge, replaying the commits if not set.
+func mergeStrategy(strategy *model.MergeStrategy) merge.Strategy {
+	if strategy == nil {
+		return merge.Replay{}
+	}
+
+	switch *strategy {
+	case model.MergeStrategyThreeWay:
+		return merge.ThreeWay{}
+	case model.MergeStrategyOurs:
+		return merge.ThreeWay{Prefer: merge.PreferSource}
+	case model.MergeStrategyTheirs:
+		return merge.ThreeWay{Prefer: merge.PreferTarget}
+	default:
+		return merge.Replay{}
+	}
+}
+
+// sortByAuthorTime sorts the commits chronologically. Git keeps the author time to the second, the commits of the
+// same second come after their ancestors.
+func sortByAuthorTime(commits []*object.Commit) {
+	depths := commitDepths(commits)
+	sort.SliceStable(commits, func(i, j int) bool {
+		a, b := commits[i], commits[j]
+		if !a.Author.When.Equal(b.Author.When) {
+			return a.Author.When.Before(b.Author.When)
+		}
+		return depths[a.Hash] < depths[b.Hash]
+	})
+}
+
+// commitDepths returns the length of the longest chain of ancestors of each commit among the commits.
+func commitDepths(commits []*object.Commit) map[plumbing.Hash]int {
+	byHash := make(map[plumbing.Hash]*object.Commit, len(commits))
+	for _, commit := range commits {
+		byHash[commit.Hash] = commit
+	}
+
+	depths := make(map[plumbing.Hash]int, len(commits))
+	var depth func(commit *object.Commit) int
+	depth = func(commit *object.Commit) int {
+		if d, ok := depths[commit.Hash]; ok {
+			return d
+		}
+		d := 0
+		for _, parentID := range commit.ParentHashes {
+			parent, ok := byHash[parentID]
+			if !ok {
+				continue
+			}
+			if parentDepth := depth(parent) + 1; parentDepth > d {
+				d = parentDepth
+			}
+		}
+		depths[commit.Hash] = d
+		return d
+	}
+	for _, commit := range commits {
+		depth(commit)
+	}
+
+	return depths
+}
@@ -0,0 +1,622 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/merge"
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+var (
+	alice = &model.Player{ID: "alice", DisplayName: "Alice"}
+	bob   = &model.Player{ID: "bob", DisplayName: "Bob"}
+)
+
+// addBranch creates the branch at the tip of another branch.
+func addBranch(t *testing.T, game *Game, branchID, fromBranchID string) {
+	ref, err := game.repo.Reference(plumbing.NewBranchReferenceName(fromBranchID), false)
+	require.NoError(t, err, "branch %s", fromBranchID)
+	err = game.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(branchID), ref.Hash()))
+	require.NoError(t, err, "add branch %s", branchID)
+}
+
+// commit commits the changes on the branch, one commit each, and returns the last commit.
+func commit(t *testing.T, game *Game, branchID string, player *model.Player, changes ...BoardChange) *object.Commit {
+	var c *object.Commit
+	for _, change := range changes {
+		var err error
+		c, err = game.CommitChange(branchID, change, player)
+		require.NoError(t, err, "commit on %s", branchID)
+	}
+
+	return c
+}
+
+// tip returns the commit at the tip of the branch.
+func tip(t *testing.T, game *Game, branchID string) plumbing.Hash {
+	ref, err := game.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+	require.NoError(t, err, "branch %s", branchID)
+
+	return ref.Hash()
+}
+
+func TestGame_MergeBranch_ConflictingCells_KeepPendingMerge(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	masterCommit := commit(t, game, masterBranch, bob, fill(0, 0, 4))
+	aliceCommit := commit(t, game, "alice", alice, fill(0, 0, 5))
+	commit(t, game, "alice", alice, fill(0, 2, 6))
+	sourceID := tip(t, game, "alice")
+
+	// Act
+	payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+
+	// Assert
+	r.NoError(err, "merge")
+	r.Nil(payload.Commit, "commit")
+	r.NotNil(payload.PendingMerge, "pending merge")
+	r.Equal("alice", payload.PendingMerge.SourceBranchID, "source")
+	r.Equal(masterBranch, payload.PendingMerge.TargetBranchID, "target")
+	r.Len(payload.PendingMerge.Conflicts, 1, "conflicts")
+	conflict := payload.PendingMerge.Conflicts[0]
+	r.Equal(0, conflict.Row, "row")
+	r.Equal(0, conflict.Col, "col")
+	r.Equal(model.MergeConflictTypeValue, conflict.Type, "type")
+	r.Equal(5, conflict.Source.Val, "source value")
+	r.Equal(aliceCommit.Hash.String(), *conflict.Source.CommitID, "source commit")
+	r.Equal(4, conflict.Target.Val, "target value")
+	r.Equal(masterCommit.Hash.String(), *conflict.Target.CommitID, "target commit")
+	r.Contains(game.merges, payload.PendingMerge.ID, "kept")
+	r.Equal(sourceID, tip(t, game, "alice"), "source untouched")
+}
+
+func TestPendingMerge_Resolve(t *testing.T) {
+	// Arrange
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	commit(t, game, masterBranch, bob, fill(0, 0, 4), fill(0, 2, 1))
+	commit(t, game, "alice", alice, fill(0, 0, 5), fill(0, 2, 2), fill(0, 4, 3))
+	refs, err := game.findMergeBases("alice", masterBranch)
+	require.NoError(t, err, "merge bases")
+	pending, err := game.Merge(refs.source, refs.target, refs.bases, merge.Replay{})
+	require.NoError(t, err, "merge")
+	require.Len(t, pending.Conflicts, 2, "conflicts")
+
+	tests := []struct {
+		name        string
+		resolutions []*model.MergeResolutionInput
+		expected    [3]int
+		err         error
+	}{
+		{
+			name: "EverySide",
+			resolutions: []*model.MergeResolutionInput{
+				{Row: 0, Col: 0, Side: model.MergeSideSource},
+				{Row: 0, Col: 2, Side: model.MergeSideTarget},
+			},
+			expected: [3]int{5, 1, 3},
+		},
+		{
+			name: "Unresolved",
+			resolutions: []*model.MergeResolutionInput{
+				{Row: 0, Col: 0, Side: model.MergeSideSource},
+			},
+			err: gqlerrors.ErrMergeConflictUnresolved(0, 2),
+		},
+		{
+			name: "NotConflict",
+			resolutions: []*model.MergeResolutionInput{
+				{Row: 0, Col: 0, Side: model.MergeSideSource},
+				{Row: 0, Col: 2, Side: model.MergeSideSource},
+				{Row: 0, Col: 4, Side: model.MergeSideTarget},
+			},
+			err: gqlerrors.ErrNotMergeConflict(0, 4),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			board, err := pending.Resolve(tt.resolutions)
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+			if tt.err != nil {
+				return
+			}
+			r.Equal(tt.expected, [3]int{board[0][0].Value, board[0][2].Value, board[0][4].Value}, "board")
+			r.Equal(5, pending.board[0][0].Value, "pending merge untouched")
+			r.Equal(2, pending.board[0][2].Value, "pending merge untouched")
+		})
+	}
+}
+
+func TestGame_MergeBranch_CommitsOfTheSameSecond_ReplayInOrder(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, git keeps the time of the commits to the second
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	commit(t, game, masterBranch, bob, fill(0, 4, 4))
+	commit(t, game, "alice", alice, fill(0, 0, 5), fill(0, 0, 7), fill(0, 0, 9))
+
+	// Act
+	payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+
+	// Assert
+	r.NoError(err, "merge")
+	r.Nil(payload.PendingMerge, "pending merge")
+	board := tipBoard(t, game, "alice")
+	r.Equal(9, board[0][0].Value, "last commit")
+	r.Equal(4, board[0][4].Value, "target commit")
+}
+
+func TestGame_MergeBranch_ResolvedConflict_ReplayResolution(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, bob fills (0, 0) after alice, who keeps her older value when merging bob
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	addBranch(t, game, "bob", masterBranch)
+	addBranch(t, game, "carol", masterBranch)
+	commit(t, game, "alice", alice, fill(0, 0, 5))
+	commit(t, game, "bob", bob, fill(0, 4, 1), fill(0, 0, 4))
+	payload, err := game.MergeBranch("alice", "bob", merge.Replay{}, alice, "")
+	r.NoError(err, "merge bob")
+	pending := game.merges[payload.PendingMerge.ID]
+	board, err := pending.Resolve([]*model.MergeResolutionInput{{Row: 0, Col: 0, Side: model.MergeSideSource}})
+	r.NoError(err, "resolve")
+	_, err = game.commitMerge(pending, board, alice)
+	r.NoError(err, "merge commit")
+	commit(t, game, "carol", carol, fill(0, 2, 6))
+
+	// Act
+	payload, err = game.MergeBranch("carol", "alice", merge.Replay{}, carol, "")
+
+	// Assert
+	r.NoError(err, "merge alice")
+	r.Nil(payload.PendingMerge, "pending merge")
+	board = tipBoard(t, game, "carol")
+	r.Equal(5, board[0][0].Value, "resolution")
+	r.Equal(6, board[0][2].Value, "source commit")
+	r.Equal(1, board[0][4].Value, "target commit")
+}
+
+func TestGame_MergeBranch_WithoutConflicts_CommitBothParents(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	commit(t, game, masterBranch, bob, fill(0, 4, 4))
+	commit(t, game, "alice", alice, fill(0, 0, 5))
+	sourceID, targetID := tip(t, game, "alice"), tip(t, game, masterBranch)
+
+	// Act
+	payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+
+	// Assert
+	r.NoError(err, "merge")
+	r.Nil(payload.PendingMerge, "pending merge")
+	r.Equal(tip(t, game, "alice").String(), payload.Commit.ID, "tip")
+	r.Equal([]string{sourceID.String(), targetID.String()}, payload.Commit.ParentIDs, "parents")
+	r.Equal(model.CommitTypeMerge, payload.Commit.Type, "type")
+	board := tipBoard(t, game, "alice")
+	r.Equal(5, board[0][0].Value, "source cell")
+	r.Equal(4, board[0][4].Value, "target cell")
+	r.Equal(targetID, tip(t, game, masterBranch), "target untouched")
+}
+
+func TestGame_MergeBranch_MergedBefore_OnlyMergeNewCommits(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, the conflict of the first merge is resolved with the source
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	commit(t, game, masterBranch, bob, fill(0, 0, 4))
+	commit(t, game, "alice", alice, fill(0, 0, 5))
+	payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+	r.NoError(err, "first merge")
+	pending := game.merges[payload.PendingMerge.ID]
+	board, err := pending.Resolve([]*model.MergeResolutionInput{{Row: 0, Col: 0, Side: model.MergeSideSource}})
+	r.NoError(err, "resolve")
+	_, err = game.commitMerge(pending, board, alice)
+	r.NoError(err, "commit merge")
+	commit(t, game, masterBranch, bob, fill(0, 2, 6))
+
+	// Act
+	payload, err = game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+
+	// Assert
+	r.NoError(err, "second merge")
+	r.Nil(payload.PendingMerge, "pending merge")
+	board = tipBoard(t, game, "alice")
+	r.Equal(5, board[0][0].Value, "resolved cell")
+	r.Equal(6, board[0][2].Value, "new target cell")
+}
+
+func TestGame_MergeBranch_SourceBehind_FastForward(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	commit(t, game, masterBranch, bob, fill(0, 0, 4))
+	targetID := tip(t, game, masterBranch)
+
+	// Act
+	payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, alice, "")
+
+	// Assert
+	r.NoError(err, "merge")
+	r.Nil(payload.Commit, "commit")
+	r.Nil(payload.PendingMerge, "pending merge")
+	r.Equal(targetID.String(), payload.SourceBranch.CommitID, "branch")
+	r.Equal(targetID, tip(t, game, "alice"), "tip")
+}
+
+func TestGame_MergeBranch_CrissCross_MergeVirtualBase(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, both branches merge each other's first commit so they have 2 merge bases, then change the cell of
+	// the other merge base
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	addBranch(t, game, "bob", masterBranch)
+	aliceBase := commit(t, game, "alice", alice, fill(0, 0, 1))
+	bobBase := commit(t, game, "bob", bob, fill(0, 2, 2))
+	addBranch(t, game, "alice-base", "alice")
+	_, err := game.MergeBranch("alice", "bob", merge.ThreeWay{}, alice, "")
+	r.NoError(err, "merge into alice")
+	_, err = game.MergeBranch("bob", "alice-base", merge.ThreeWay{}, bob, "")
+	r.NoError(err, "merge into bob")
+	commit(t, game, "alice", alice, fill(0, 2, 9))
+	commit(t, game, "bob", bob, fill(0, 0, 7))
+	refs, err := game.findMergeBases("alice", "bob")
+	r.NoError(err, "merge bases")
+	r.ElementsMatch([]plumbing.Hash{aliceBase.Hash, bobBase.Hash}, []plumbing.Hash{refs.bases[0].Hash, refs.bases[1].Hash}, "merge bases")
+
+	// Act
+	base, err := game.virtualBase(refs.bases)
+	r.NoError(err, "virtual base")
+	payload, err := game.MergeBranch("alice", "bob", merge.ThreeWay{}, alice, "")
+
+	// Assert
+	r.Equal(1, base.board[0][0].Value, "alice base")
+	r.Equal(2, base.board[0][2].Value, "bob base")
+	r.True(base.commits[aliceBase.Hash], "alice base commit")
+	r.True(base.commits[bobBase.Hash], "bob base commit")
+	r.NoError(err, "merge")
+	r.Nil(payload.PendingMerge, "each cell only changed on 1 branch since the virtual base")
+	board := tipBoard(t, game, "alice")
+	r.Equal(7, board[0][0].Value, "bob cell")
+	r.Equal(9, board[0][2].Value, "alice cell")
+}
+
+func TestGame_VirtualBase_WithoutBase_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+
+	// Act
+	_, err := game.virtualBase(nil)
+
+	// Assert
+	r.Error(err, "err")
+}
+
+func TestGame_MergeBranch_Strategies(t *testing.T) {
+	strategy := func(s model.MergeStrategy) *model.MergeStrategy {
+		return &s
+	}
+	toggleNote := func(row, col, val int) BoardChange {
+		return func(board engine.Board) (string, error) {
+			board[row][col].Notes[val-1] = !board[row][col].Notes[val-1]
+			return fmt.Sprintf("%s %d %d %d", model.CommitTypeToggleNote, row, col, val), nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		strategy  *model.MergeStrategy
+		conflicts int
+		expected  int
+	}{
+		{
+			name:      "Replay",
+			conflicts: 1,
+			expected:  5,
+		},
+		{
+			name:      "ThreeWay",
+			strategy:  strategy(model.MergeStrategyThreeWay),
+			conflicts: 1,
+			expected:  5,
+		},
+		{
+			name:     "Ours",
+			strategy: strategy(model.MergeStrategyOurs),
+			expected: 5,
+		},
+		{
+			name:     "Theirs",
+			strategy: strategy(model.MergeStrategyTheirs),
+			expected: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange, both branches fill (0, 0), only alice notes (0, 2) and only bob fills it
+			game := newTestGame(t)
+			addBranch(t, game, "alice", masterBranch)
+			commit(t, game, masterBranch, bob, fill(0, 0, 4), fill(0, 2, 6))
+			commit(t, game, "alice", alice, fill(0, 0, 5), toggleNote(0, 2, 3))
+
+			// Act
+			payload, err := game.MergeBranch("alice", masterBranch, mergeStrategy(tt.strategy), alice, "")
+
+			// Assert
+			r.NoError(err, "merge")
+			board := tipBoard(t, game, "alice")
+			if tt.conflicts > 0 {
+				r.Len(payload.PendingMerge.Conflicts, tt.conflicts, "conflicts")
+				board = game.merges[payload.PendingMerge.ID].board
+			} else {
+				r.Nil(payload.PendingMerge, "pending merge")
+			}
+			r.Equal(tt.expected, board[0][0].Value, "conflicting cell")
+			r.Equal(6, board[0][2].Value, "target value")
+			r.True(board[0][2].Notes[2], "source notes")
+		})
+	}
+}
+
+func TestMutationResolver_ResolveMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		// moveSource commits on the source branch once the merge is pending
+		moveSource bool
+		err        error
+	}{
+		{
+			name: "Committed",
+		},
+		{
+			name:       "SourceMoved",
+			moveSource: true,
+			err:        gqlerrors.ErrBranchChanged("alice"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			res, game := newTestResolver(t)
+			ctx, player := join(t, res)
+			addBranch(t, game, "alice", masterBranch)
+			commit(t, game, masterBranch, player, fill(0, 0, 4))
+			commit(t, game, "alice", player, fill(0, 0, 5))
+			payload, err := game.MergeBranch("alice", masterBranch, merge.Replay{}, player, "")
+			r.NoError(err, "merge")
+			mergeID := payload.PendingMerge.ID
+			if tt.moveSource {
+				commit(t, game, "alice", player, fill(0, 2, 6))
+			}
+
+			// Act
+			resolved, err := (&mutationResolver{res}).ResolveMerge(ctx, model.ResolveMergeInput{
+				GameID:      DefaultGameID,
+				MergeID:     mergeID,
+				Resolutions: []*model.MergeResolutionInput{{Row: 0, Col: 0, Side: model.MergeSideTarget}},
+			})
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+			_, pending := game.merges[mergeID]
+			if tt.err != nil {
+				r.True(pending, "merge kept")
+				return
+			}
+			r.False(pending, "merge done with")
+			r.Equal(tip(t, game, "alice").String(), resolved.Commit.ID, "merge commit")
+			r.Equal(4, tipBoard(t, game, "alice")[0][0].Value, "resolved cell")
+		})
+	}
+}
+
+func TestMutationResolver_MergeBranch(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceBranchID string
+		targetBranchID string
+		err            error
+	}{
+		{
+			name:           "IntoBranch",
+			sourceBranchID: "alice",
+			targetBranchID: masterBranch,
+		},
+		{
+			name:           "IntoMaster",
+			sourceBranchID: masterBranch,
+			targetBranchID: "alice",
+			err:            gqlerrors.ErrBranchRequiresApproval(masterBranch),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			res, game := newTestResolver(t)
+			ctx, player := join(t, res)
+			addBranch(t, game, "alice", masterBranch)
+			commit(t, game, masterBranch, player, fill(0, 0, 4))
+			commit(t, game, "alice", player, fill(0, 2, 6))
+			sourceID := tip(t, game, tt.sourceBranchID)
+
+			// Act
+			payload, err := (&mutationResolver{res}).MergeBranch(ctx, model.MergeBranchInput{
+				GameID:         DefaultGameID,
+				SourceBranchID: tt.sourceBranchID,
+				TargetBranchID: tt.targetBranchID,
+			})
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+			if tt.err != nil {
+				r.Equal(sourceID, tip(t, game, tt.sourceBranchID), "source untouched")
+				return
+			}
+			r.Equal(tip(t, game, tt.sourceBranchID).String(), payload.Commit.ID, "merge commit")
+		})
+	}
+}
+
+func TestGame_PreviewMerge(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	addBranch(t, game, "alice", masterBranch)
+	addBranch(t, game, "behind", masterBranch)
+	commit(t, game, masterBranch, bob, fill(0, 0, 4), fill(0, 2, 6))
+	commit(t, game, "alice", alice, fill(0, 0, 5))
+	sourceID, behindID := tip(t, game, "alice"), tip(t, game, "behind")
+
+	// Act
+	preview, err := game.PreviewMerge("alice", masterBranch, merge.Replay{})
+	r.NoError(err, "preview")
+	fastForward, err := game.PreviewMerge("behind", masterBranch, merge.Replay{})
+	r.NoError(err, "fast-forward preview")
+
+	// Assert
+	r.False(preview.FastForward, "fast-forward")
+	r.Len(preview.Conflicts, 1, "conflicts")
+	r.Equal(0, preview.Conflicts[0].Col, "conflict")
+	r.Len(preview.Changes, 1, "changes")
+	r.Equal(2, preview.Changes[0].Col, "change")
+	r.Equal(0, preview.Changes[0].Before.Val, "before")
+	r.Equal(6, preview.Changes[0].After.Val, "after")
+	r.True(fastForward.FastForward, "fast-forward")
+	r.Len(fastForward.Changes, 2, "fast-forward changes")
+	r.Empty(fastForward.Conflicts, "fast-forward conflicts")
+	r.Equal(sourceID, tip(t, game, "alice"), "source untouched")
+	r.Equal(behindID, tip(t, game, "behind"), "fast-forward source untouched")
+	r.Empty(game.merges, "merges")
+}
+
+func TestSubscriptionResolver_BranchEvents_CreateAndMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		// act changes the branches as the player of ctx, and returns the event expected for it
+		act func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) *model.BranchEvent
+	}{
+		{
+			name: "AddBranch",
+			act: func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) *model.BranchEvent {
+				from := masterBranch
+				_, err := (&mutationResolver{res}).AddBranch(ctx, model.AddBranchInput{GameID: DefaultGameID, ID: "bob", BranchID: &from})
+				require.NoError(t, err, "add branch")
+
+				game, _ := res.getGame(DefaultGameID)
+				return &model.BranchEvent{
+					Type:        model.BranchEventTypeCreated,
+					BranchID:    "bob",
+					NewCommitID: StringPtr(tip(t, game, masterBranch).String()),
+					Player:      player,
+				}
+			},
+		},
+		{
+			name: "FastForward",
+			act: func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) *model.BranchEvent {
+				game, _ := res.getGame(DefaultGameID)
+				commit(t, game, masterBranch, player, fill(0, 0, 4))
+				oldID := tip(t, game, "alice")
+				_, err := (&mutationResolver{res}).MergeBranch(ctx, model.MergeBranchInput{GameID: DefaultGameID, SourceBranchID: "alice", TargetBranchID: masterBranch})
+				require.NoError(t, err, "merge")
+
+				return &model.BranchEvent{
+					Type:           model.BranchEventTypeFastForwarded,
+					BranchID:       "alice",
+					SourceBranchID: StringPtr(masterBranch),
+					OldCommitID:    StringPtr(oldID.String()),
+					NewCommitID:    StringPtr(tip(t, game, masterBranch).String()),
+					Player:         player,
+				}
+			},
+		},
+		{
+			name: "MergeBranch",
+			act: func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) *model.BranchEvent {
+				game, _ := res.getGame(DefaultGameID)
+				commit(t, game, masterBranch, player, fill(0, 0, 4))
+				commit(t, game, "alice", player, fill(0, 2, 6))
+				oldID := tip(t, game, "alice")
+				payload, err := (&mutationResolver{res}).MergeBranch(ctx, model.MergeBranchInput{GameID: DefaultGameID, SourceBranchID: "alice", TargetBranchID: masterBranch})
+				require.NoError(t, err, "merge")
+
+				return &model.BranchEvent{
+					Type:           model.BranchEventTypeMerged,
+					BranchID:       "alice",
+					SourceBranchID: StringPtr(masterBranch),
+					OldCommitID:    StringPtr(oldID.String()),
+					NewCommitID:    StringPtr(payload.Commit.ID),
+					Player:         player,
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			res, game := newTestResolver(t)
+			ctx, player := join(t, res)
+			addBranch(t, game, "alice", masterBranch)
+			subCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := (&subscriptionResolver{res}).BranchEvents(subCtx, DefaultGameID)
+			r.NoError(err, "subscribe")
+
+			// Act
+			expected := tt.act(t, res, ctx, player)
+
+			// Assert
+			var event *model.BranchEvent
+			select {
+			case event = <-events:
+			case <-time.After(5 * time.Second):
+				r.FailNow("no branch event")
+			}
+			r.NotNil(event, "event")
+			r.False(event.Timestamp.IsZero(), "timestamp")
+			event.Timestamp = time.Time{}
+			r.Equal(expected, event, "event")
+		})
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type AddBranchInput struct {
+	GameID   string  `json:"gameId"`
 	ID       string  `json:"id"`
 	CommitID *string `json:"commitId"`
 	BranchID *string `json:"branchId"`
@@ -19,6 +21,7 @@ type AddBranchPayload struct {
 }
 
 type AddCommitInput struct {
+	GameID   string     `json:"gameId"`
 	BranchID string     `json:"branchId"`
 	Type     CommitType `json:"type"`
 	Row      int        `json:"row"`
@@ -30,23 +33,411 @@ type AddCommitPayload struct {
 	Commit *Commit `json:"commit"`
 }
 
+type BranchEvent struct {
+	Type           BranchEventType `json:"type"`
+	BranchID       string          `json:"branchId"`
+	SourceBranchID *string         `json:"sourceBranchId"`
+	OldCommitID    *string         `json:"oldCommitId"`
+	NewCommitID    *string         `json:"newCommitId"`
+	Player         *Player         `json:"player"`
+	Timestamp      time.Time       `json:"timestamp"`
+}
+
+type BranchProtection struct {
+	OwnerOnly         bool `json:"ownerOnly"`
+	NoForceReset      bool `json:"noForceReset"`
+	RequireApproval   bool `json:"requireApproval"`
+	RequiredApprovals int  `json:"requiredApprovals"`
+}
+
+type CellChange struct {
+	Row    int   `json:"row"`
+	Col    int   `json:"col"`
+	Before *Cell `json:"before"`
+	After  *Cell `json:"after"`
+}
+
+type CellConflict struct {
+	Row      int    `json:"row"`
+	Col      int    `json:"col"`
+	CommitID string `json:"commitId"`
+	Expected *Cell  `json:"expected"`
+	Actual   *Cell  `json:"actual"`
+}
+
+type CherryPickInput struct {
+	GameID   string `json:"gameId"`
+	CommitID string `json:"commitId"`
+	BranchID string `json:"branchId"`
+}
+
+type CherryPickPayload struct {
+	Commit   *Commit       `json:"commit"`
+	Conflict *CellConflict `json:"conflict"`
+}
+
+type CommentProposalInput struct {
+	GameID     string `json:"gameId"`
+	ProposalID string `json:"proposalId"`
+	Text       string `json:"text"`
+}
+
+type CommentProposalPayload struct {
+	Proposal *Proposal        `json:"proposal"`
+	Comment  *ProposalComment `json:"comment"`
+}
+
+type CreateGameInput struct {
+	Puzzle     *string     `json:"puzzle"`
+	PuzzleName *string     `json:"puzzleName"`
+	Difficulty *Difficulty `json:"difficulty"`
+}
+
+type CreateGamePayload struct {
+	Game *Game `json:"game"`
+}
+
+type DeleteBranchInput struct {
+	GameID   string `json:"gameId"`
+	BranchID string `json:"branchId"`
+}
+
+type DeleteBranchPayload struct {
+	Branch *Branch `json:"branch"`
+}
+
 type JoinPayload struct {
 	Player *Player `json:"player"`
+	Token  string  `json:"token"`
+}
+
+type LeavePayload struct {
+	Player *Player `json:"player"`
 }
 
 type MergeBranchInput struct {
-	SourceBranchID string `json:"sourceBranchId"`
-	TargetBranchID string `json:"targetBranchId"`
-	AuthorID       string `json:"authorId"`
+	GameID         string         `json:"gameId"`
+	SourceBranchID string         `json:"sourceBranchId"`
+	TargetBranchID string         `json:"targetBranchId"`
+	AuthorID       string         `json:"authorId"`
+	Strategy       *MergeStrategy `json:"strategy"`
 }
 
 type MergeBranchPayload struct {
-	SourceBranch *Branch `json:"sourceBranch"`
+	SourceBranch *Branch       `json:"sourceBranch"`
+	Commit       *Commit       `json:"commit"`
+	PendingMerge *PendingMerge `json:"pendingMerge"`
+}
+
+type MergeConflict struct {
+	Row    int                `json:"row"`
+	Col    int                `json:"col"`
+	Type   MergeConflictType  `json:"type"`
+	Source *MergeConflictSide `json:"source"`
+	Target *MergeConflictSide `json:"target"`
+}
+
+type MergeConflictSide struct {
+	Val      int     `json:"val"`
+	Notes    []int   `json:"notes"`
+	CommitID *string `json:"commitId"`
+	AuthorID *string `json:"authorId"`
+}
+
+type MergePreview struct {
+	FastForward bool             `json:"fastForward"`
+	Blob        *Blob            `json:"blob"`
+	Changes     []*CellChange    `json:"changes"`
+	Conflicts   []*MergeConflict `json:"conflicts"`
+}
+
+type MergeResolutionInput struct {
+	Row  int       `json:"row"`
+	Col  int       `json:"col"`
+	Side MergeSide `json:"side"`
+}
+
+type Message struct {
+	ID        string         `json:"id"`
+	Author    *Player        `json:"author"`
+	BranchID  *string        `json:"branchId"`
+	Text      string         `json:"text"`
+	Links     []*MessageLink `json:"links"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+type MessageLink struct {
+	Type     MessageLinkType `json:"type"`
+	Text     string          `json:"text"`
+	Start    int             `json:"start"`
+	End      int             `json:"end"`
+	Row      *int            `json:"row"`
+	Col      *int            `json:"col"`
+	CommitID *string         `json:"commitId"`
+}
+
+type OpenProposalInput struct {
+	GameID         string         `json:"gameId"`
+	SourceBranchID string         `json:"sourceBranchId"`
+	TargetBranchID string         `json:"targetBranchId"`
+	Title          *string        `json:"title"`
+	Strategy       *MergeStrategy `json:"strategy"`
+}
+
+type OpenProposalPayload struct {
+	Proposal *Proposal `json:"proposal"`
+}
+
+type PendingMerge struct {
+	ID             string           `json:"id"`
+	SourceBranchID string           `json:"sourceBranchId"`
+	TargetBranchID string           `json:"targetBranchId"`
+	Conflicts      []*MergeConflict `json:"conflicts"`
 }
 
 type Player struct {
 	ID          string `json:"id"`
 	DisplayName string `json:"displayName"`
+	Color       string `json:"color"`
+}
+
+type PlayersChangedEvent struct {
+	Type    PlayerEventType `json:"type"`
+	Player  *Player         `json:"player"`
+	Players []*Player       `json:"players"`
+}
+
+type Presence struct {
+	Player    *Player   `json:"player"`
+	BranchID  string    `json:"branchId"`
+	Row       int       `json:"row"`
+	Col       int       `json:"col"`
+	Idle      bool      `json:"idle"`
+	Online    bool      `json:"online"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Proposal struct {
+	ID             string             `json:"id"`
+	SourceBranchID string             `json:"sourceBranchId"`
+	TargetBranchID string             `json:"targetBranchId"`
+	Title          string             `json:"title"`
+	Author         *Player            `json:"author"`
+	Strategy       MergeStrategy      `json:"strategy"`
+	Status         ProposalStatus     `json:"status"`
+	Diff           *MergePreview      `json:"diff"`
+	Comments       []*ProposalComment `json:"comments"`
+	Votes          []*ProposalVote    `json:"votes"`
+	Threshold      int                `json:"threshold"`
+	PendingMerge   *PendingMerge      `json:"pendingMerge"`
+	CommitID       *string            `json:"commitId"`
+	CreatedAt      time.Time          `json:"createdAt"`
+}
+
+type ProposalComment struct {
+	ID        string    `json:"id"`
+	Author    *Player   `json:"author"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ProposalEvent struct {
+	Type      ProposalEventType `json:"type"`
+	Proposal  *Proposal         `json:"proposal"`
+	Player    *Player           `json:"player"`
+	Comment   *ProposalComment  `json:"comment"`
+	Timestamp time.Time         `json:"timestamp"`
+}
+
+type ProposalVote struct {
+	Player    *Player          `json:"player"`
+	Type      ProposalVoteType `json:"type"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+type PuzzleMetadata struct {
+	Name       string       `json:"name"`
+	Source     PuzzleSource `json:"source"`
+	Difficulty Difficulty   `json:"difficulty"`
+	Givens     int          `json:"givens"`
+}
+
+type RebaseBranchInput struct {
+	GameID       string `json:"gameId"`
+	BranchID     string `json:"branchId"`
+	OntoBranchID string `json:"ontoBranchId"`
+	Squash       *bool  `json:"squash"`
+}
+
+type RebaseBranchPayload struct {
+	Branch    *Branch           `json:"branch"`
+	Commits   []*Commit         `json:"commits"`
+	Conflicts []*RebaseConflict `json:"conflicts"`
+}
+
+type RebaseConflict struct {
+	CommitID string `json:"commitId"`
+	Row      int    `json:"row"`
+	Col      int    `json:"col"`
+	Base     *Cell  `json:"base"`
+	Onto     *Cell  `json:"onto"`
+}
+
+type RedoPayload struct {
+	Commit   *Commit       `json:"commit"`
+	Conflict *CellConflict `json:"conflict"`
+}
+
+type RenameBranchInput struct {
+	GameID      string `json:"gameId"`
+	BranchID    string `json:"branchId"`
+	NewBranchID string `json:"newBranchId"`
+}
+
+type RenameBranchPayload struct {
+	Branch *Branch `json:"branch"`
+}
+
+type ResetBranchInput struct {
+	GameID   string `json:"gameId"`
+	BranchID string `json:"branchId"`
+	CommitID string `json:"commitId"`
+}
+
+type ResetBranchPayload struct {
+	Branch *Branch `json:"branch"`
+}
+
+type ResolveMergeInput struct {
+	GameID      string                  `json:"gameId"`
+	MergeID     string                  `json:"mergeId"`
+	Resolutions []*MergeResolutionInput `json:"resolutions"`
+}
+
+type ResolveMergePayload struct {
+	SourceBranch *Branch `json:"sourceBranch"`
+	Commit       *Commit `json:"commit"`
+}
+
+type RevertCommitInput struct {
+	GameID   string `json:"gameId"`
+	CommitID string `json:"commitId"`
+	BranchID string `json:"branchId"`
+}
+
+type RevertCommitPayload struct {
+	Commit   *Commit       `json:"commit"`
+	Conflict *CellConflict `json:"conflict"`
+}
+
+type SendMessageInput struct {
+	GameID   string  `json:"gameId"`
+	BranchID *string `json:"branchId"`
+	Text     string  `json:"text"`
+}
+
+type SendMessagePayload struct {
+	Message *Message `json:"message"`
+}
+
+type SetBranchProtectionInput struct {
+	GameID            string `json:"gameId"`
+	BranchID          string `json:"branchId"`
+	OwnerOnly         *bool  `json:"ownerOnly"`
+	NoForceReset      *bool  `json:"noForceReset"`
+	RequireApproval   *bool  `json:"requireApproval"`
+	RequiredApprovals *int   `json:"requiredApprovals"`
+}
+
+type SetBranchProtectionPayload struct {
+	Branch *Branch `json:"branch"`
+}
+
+type SetCursorInput struct {
+	GameID   string `json:"gameId"`
+	BranchID string `json:"branchId"`
+	Row      int    `json:"row"`
+	Col      int    `json:"col"`
+}
+
+type SetCursorPayload struct {
+	Presence *Presence `json:"presence"`
+}
+
+type UndoPayload struct {
+	Commit   *Commit       `json:"commit"`
+	Conflict *CellConflict `json:"conflict"`
+}
+
+type UpdatePlayerInput struct {
+	GameID      string  `json:"gameId"`
+	DisplayName *string `json:"displayName"`
+	Color       *string `json:"color"`
+}
+
+type UpdatePlayerPayload struct {
+	Player *Player `json:"player"`
+}
+
+type VoteProposalInput struct {
+	GameID     string           `json:"gameId"`
+	ProposalID string           `json:"proposalId"`
+	Type       ProposalVoteType `json:"type"`
+}
+
+type VoteProposalPayload struct {
+	Proposal *Proposal `json:"proposal"`
+}
+
+type BranchEventType string
+
+const (
+	BranchEventTypeCreated       BranchEventType = "CREATED"
+	BranchEventTypeDeleted       BranchEventType = "DELETED"
+	BranchEventTypeFastForwarded BranchEventType = "FAST_FORWARDED"
+	BranchEventTypeMerged        BranchEventType = "MERGED"
+	BranchEventTypeRebased       BranchEventType = "REBASED"
+	BranchEventTypeRenamed       BranchEventType = "RENAMED"
+	BranchEventTypeReset         BranchEventType = "RESET"
+)
+
+var AllBranchEventType = []BranchEventType{
+	BranchEventTypeCreated,
+	BranchEventTypeDeleted,
+	BranchEventTypeFastForwarded,
+	BranchEventTypeMerged,
+	BranchEventTypeRebased,
+	BranchEventTypeRenamed,
+	BranchEventTypeReset,
+}
+
+func (e BranchEventType) IsValid() bool {
+	switch e {
+	case BranchEventTypeCreated, BranchEventTypeDeleted, BranchEventTypeFastForwarded, BranchEventTypeMerged, BranchEventTypeRebased, BranchEventTypeRenamed, BranchEventTypeReset:
+		return true
+	}
+	return false
+}
+
+func (e BranchEventType) String() string {
+	return string(e)
+}
+
+func (e *BranchEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BranchEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BranchEventType", str)
+	}
+	return nil
+}
+
+func (e BranchEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
 type CommitType string
@@ -58,6 +449,7 @@ const (
 	CommitTypeRemoveFill CommitType = "REMOVE_FILL"
 	CommitTypeToggleNote CommitType = "TOGGLE_NOTE"
 	CommitTypeMerge      CommitType = "MERGE"
+	CommitTypeSquash     CommitType = "SQUASH"
 )
 
 var AllCommitType = []CommitType{
@@ -67,11 +459,12 @@ var AllCommitType = []CommitType{
 	CommitTypeRemoveFill,
 	CommitTypeToggleNote,
 	CommitTypeMerge,
+	CommitTypeSquash,
 }
 
 func (e CommitType) IsValid() bool {
 	switch e {
-	case CommitTypeUnknown, CommitTypeInitial, CommitTypeAddFill, CommitTypeRemoveFill, CommitTypeToggleNote, CommitTypeMerge:
+	case CommitTypeUnknown, CommitTypeInitial, CommitTypeAddFill, CommitTypeRemoveFill, CommitTypeToggleNote, CommitTypeMerge, CommitTypeSquash:
 		return true
 	}
 	return false
@@ -97,3 +490,441 @@ func (e *CommitType) UnmarshalGQL(v interface{}) error {
 func (e CommitType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type Difficulty string
+
+const (
+	DifficultyEasy   Difficulty = "EASY"
+	DifficultyMedium Difficulty = "MEDIUM"
+	DifficultyHard   Difficulty = "HARD"
+	DifficultyExpert Difficulty = "EXPERT"
+)
+
+var AllDifficulty = []Difficulty{
+	DifficultyEasy,
+	DifficultyMedium,
+	DifficultyHard,
+	DifficultyExpert,
+}
+
+func (e Difficulty) IsValid() bool {
+	switch e {
+	case DifficultyEasy, DifficultyMedium, DifficultyHard, DifficultyExpert:
+		return true
+	}
+	return false
+}
+
+func (e Difficulty) String() string {
+	return string(e)
+}
+
+func (e *Difficulty) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Difficulty(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Difficulty", str)
+	}
+	return nil
+}
+
+func (e Difficulty) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MergeConflictType string
+
+const (
+	MergeConflictTypeValue     MergeConflictType = "VALUE"
+	MergeConflictTypeFillErase MergeConflictType = "FILL_ERASE"
+	MergeConflictTypeNotes     MergeConflictType = "NOTES"
+)
+
+var AllMergeConflictType = []MergeConflictType{
+	MergeConflictTypeValue,
+	MergeConflictTypeFillErase,
+	MergeConflictTypeNotes,
+}
+
+func (e MergeConflictType) IsValid() bool {
+	switch e {
+	case MergeConflictTypeValue, MergeConflictTypeFillErase, MergeConflictTypeNotes:
+		return true
+	}
+	return false
+}
+
+func (e MergeConflictType) String() string {
+	return string(e)
+}
+
+func (e *MergeConflictType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MergeConflictType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MergeConflictType", str)
+	}
+	return nil
+}
+
+func (e MergeConflictType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MergeSide string
+
+const (
+	MergeSideSource MergeSide = "SOURCE"
+	MergeSideTarget MergeSide = "TARGET"
+)
+
+var AllMergeSide = []MergeSide{
+	MergeSideSource,
+	MergeSideTarget,
+}
+
+func (e MergeSide) IsValid() bool {
+	switch e {
+	case MergeSideSource, MergeSideTarget:
+		return true
+	}
+	return false
+}
+
+func (e MergeSide) String() string {
+	return string(e)
+}
+
+func (e *MergeSide) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MergeSide(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MergeSide", str)
+	}
+	return nil
+}
+
+func (e MergeSide) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MergeStrategy string
+
+const (
+	MergeStrategyReplay   MergeStrategy = "REPLAY"
+	MergeStrategyThreeWay MergeStrategy = "THREE_WAY"
+	MergeStrategyOurs     MergeStrategy = "OURS"
+	MergeStrategyTheirs   MergeStrategy = "THEIRS"
+)
+
+var AllMergeStrategy = []MergeStrategy{
+	MergeStrategyReplay,
+	MergeStrategyThreeWay,
+	MergeStrategyOurs,
+	MergeStrategyTheirs,
+}
+
+func (e MergeStrategy) IsValid() bool {
+	switch e {
+	case MergeStrategyReplay, MergeStrategyThreeWay, MergeStrategyOurs, MergeStrategyTheirs:
+		return true
+	}
+	return false
+}
+
+func (e MergeStrategy) String() string {
+	return string(e)
+}
+
+func (e *MergeStrategy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MergeStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MergeStrategy", str)
+	}
+	return nil
+}
+
+func (e MergeStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MessageLinkType string
+
+const (
+	MessageLinkTypeCell   MessageLinkType = "CELL"
+	MessageLinkTypeCommit MessageLinkType = "COMMIT"
+)
+
+var AllMessageLinkType = []MessageLinkType{
+	MessageLinkTypeCell,
+	MessageLinkTypeCommit,
+}
+
+func (e MessageLinkType) IsValid() bool {
+	switch e {
+	case MessageLinkTypeCell, MessageLinkTypeCommit:
+		return true
+	}
+	return false
+}
+
+func (e MessageLinkType) String() string {
+	return string(e)
+}
+
+func (e *MessageLinkType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageLinkType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageLinkType", str)
+	}
+	return nil
+}
+
+func (e MessageLinkType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PlayerEventType string
+
+const (
+	PlayerEventTypeJoined  PlayerEventType = "JOINED"
+	PlayerEventTypeUpdated PlayerEventType = "UPDATED"
+	PlayerEventTypeLeft    PlayerEventType = "LEFT"
+)
+
+var AllPlayerEventType = []PlayerEventType{
+	PlayerEventTypeJoined,
+	PlayerEventTypeUpdated,
+	PlayerEventTypeLeft,
+}
+
+func (e PlayerEventType) IsValid() bool {
+	switch e {
+	case PlayerEventTypeJoined, PlayerEventTypeUpdated, PlayerEventTypeLeft:
+		return true
+	}
+	return false
+}
+
+func (e PlayerEventType) String() string {
+	return string(e)
+}
+
+func (e *PlayerEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlayerEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlayerEventType", str)
+	}
+	return nil
+}
+
+func (e PlayerEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ProposalEventType string
+
+const (
+	ProposalEventTypeOpened    ProposalEventType = "OPENED"
+	ProposalEventTypeCommented ProposalEventType = "COMMENTED"
+	ProposalEventTypeVoted     ProposalEventType = "VOTED"
+	ProposalEventTypeApproved  ProposalEventType = "APPROVED"
+	ProposalEventTypeMerged    ProposalEventType = "MERGED"
+	ProposalEventTypeRejected  ProposalEventType = "REJECTED"
+)
+
+var AllProposalEventType = []ProposalEventType{
+	ProposalEventTypeOpened,
+	ProposalEventTypeCommented,
+	ProposalEventTypeVoted,
+	ProposalEventTypeApproved,
+	ProposalEventTypeMerged,
+	ProposalEventTypeRejected,
+}
+
+func (e ProposalEventType) IsValid() bool {
+	switch e {
+	case ProposalEventTypeOpened, ProposalEventTypeCommented, ProposalEventTypeVoted, ProposalEventTypeApproved, ProposalEventTypeMerged, ProposalEventTypeRejected:
+		return true
+	}
+	return false
+}
+
+func (e ProposalEventType) String() string {
+	return string(e)
+}
+
+func (e *ProposalEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProposalEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProposalEventType", str)
+	}
+	return nil
+}
+
+func (e ProposalEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ProposalStatus string
+
+const (
+	ProposalStatusOpen     ProposalStatus = "OPEN"
+	ProposalStatusApproved ProposalStatus = "APPROVED"
+	ProposalStatusMerged   ProposalStatus = "MERGED"
+	ProposalStatusRejected ProposalStatus = "REJECTED"
+)
+
+var AllProposalStatus = []ProposalStatus{
+	ProposalStatusOpen,
+	ProposalStatusApproved,
+	ProposalStatusMerged,
+	ProposalStatusRejected,
+}
+
+func (e ProposalStatus) IsValid() bool {
+	switch e {
+	case ProposalStatusOpen, ProposalStatusApproved, ProposalStatusMerged, ProposalStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e ProposalStatus) String() string {
+	return string(e)
+}
+
+func (e *ProposalStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProposalStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProposalStatus", str)
+	}
+	return nil
+}
+
+func (e ProposalStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ProposalVoteType string
+
+const (
+	ProposalVoteTypeApprove ProposalVoteType = "APPROVE"
+	ProposalVoteTypeReject  ProposalVoteType = "REJECT"
+)
+
+var AllProposalVoteType = []ProposalVoteType{
+	ProposalVoteTypeApprove,
+	ProposalVoteTypeReject,
+}
+
+func (e ProposalVoteType) IsValid() bool {
+	switch e {
+	case ProposalVoteTypeApprove, ProposalVoteTypeReject:
+		return true
+	}
+	return false
+}
+
+func (e ProposalVoteType) String() string {
+	return string(e)
+}
+
+func (e *ProposalVoteType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProposalVoteType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProposalVoteType", str)
+	}
+	return nil
+}
+
+func (e ProposalVoteType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PuzzleSource string
+
+const (
+	PuzzleSourceString    PuzzleSource = "STRING"
+	PuzzleSourceFile      PuzzleSource = "FILE"
+	PuzzleSourceLibrary   PuzzleSource = "LIBRARY"
+	PuzzleSourceGenerated PuzzleSource = "GENERATED"
+)
+
+var AllPuzzleSource = []PuzzleSource{
+	PuzzleSourceString,
+	PuzzleSourceFile,
+	PuzzleSourceLibrary,
+	PuzzleSourceGenerated,
+}
+
+func (e PuzzleSource) IsValid() bool {
+	switch e {
+	case PuzzleSourceString, PuzzleSourceFile, PuzzleSourceLibrary, PuzzleSourceGenerated:
+		return true
+	}
+	return false
+}
+
+func (e PuzzleSource) String() string {
+	return string(e)
+}
+
+func (e *PuzzleSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PuzzleSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PuzzleSource", str)
+	}
+	return nil
+}
+
+func (e PuzzleSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
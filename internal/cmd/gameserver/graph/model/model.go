@@ -6,7 +6,12 @@ import (
 
 type AddObserverCleanUpFunc func()
 
+type Game struct {
+	ID string `json:"id"`
+}
+
 type Commit struct {
+	GameID          string     `json:"-"`
 	ID              string     `json:"id"`
 	ParentIDs       []string   `json:"parentIds"`
 	Type            CommitType `json:"type"`
@@ -18,13 +23,16 @@ type Commit struct {
 }
 
 type Branch struct {
+	GameID   string `json:"-"`
 	ID       string `json:"id"`
 	CommitID string `json:"commitId"`
 }
 
 type Sudoku struct {
-	BranchID string  `json:"branchId"`
-	Board    [][]int `json:"board"`
+	GameID   string          `json:"-"`
+	BranchID string          `json:"branchId"`
+	Board    [][]int         `json:"board"`
+	Puzzle   *PuzzleMetadata `json:"puzzle"`
 }
 
 type Cell struct {
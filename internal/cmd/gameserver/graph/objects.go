@@ -0,0 +1,391 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/storage"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+// maxCommitAttempts is how many times a change is applied again on the new tip of a branch that moved concurrently
+const maxCommitAttempts = 5
+
+// BoardChange changes the board of the tip of a branch, and returns the message of the commit recording it.
+type BoardChange func(board engine.Board) (string, error)
+
+// writeCommit stores the board as the only file of a new commit, straight as git objects without any worktree.
+func writeCommit(s storer.EncodedObjectStorer, board engine.Board, message string, sig *object.Signature, parents ...plumbing.Hash) (plumbing.Hash, error) {
+	content, err := board.Marshal()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to marshal board: %w", err)
+	}
+
+	blob := s.NewEncodedObject()
+	blob.SetType(plumbing.BlobObject)
+	w, err := blob.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to write blob: %w", err)
+	}
+	_, err = w.Write(content)
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to write blob: %w", err)
+	}
+	blobID, err := s.SetEncodedObject(blob)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	tree := &object.Tree{
+		Entries: []object.TreeEntry{
+			{Name: gameFile, Mode: filemode.Regular, Hash: blobID},
+		},
+	}
+	treeID, err := writeObject(s, tree)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to store tree: %w", err)
+	}
+
+	commit := &object.Commit{
+		Author:       *sig,
+		Committer:    *sig,
+		Message:      message,
+		TreeHash:     treeID,
+		ParentHashes: parents,
+	}
+	commitID, err := writeObject(s, commit)
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("failed to store commit: %w", err)
+	}
+
+	return commitID, nil
+}
+
+// newSignature signs a commit made now by the player.
+func newSignature(player *model.Player) *object.Signature {
+	return &object.Signature{
+		Name:  player.DisplayName,
+		Email: player.ID,
+		When:  time.Now(),
+	}
+}
+
+func writeObject(s storer.EncodedObjectStorer, o object.Object) (plumbing.Hash, error) {
+	obj := s.NewEncodedObject()
+	err := o.Encode(obj)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return s.SetEncodedObject(obj)
+}
+
+// CommitBoard commits the board on top of the branch, with the tip of the branch as first parent followed by the
+// other parents. It returns storage.ErrReferenceHasChanged if the branch moved since ref was read.
+func (g *Game) CommitBoard(ref *plumbing.Reference, board engine.Board, message string, player *model.Player, parents ...plumbing.Hash) (*object.Commit, error) {
+	commitID, err := writeCommit(g.repo.Storer, board, message, newSignature(player), append([]plumbing.Hash{ref.Hash()}, parents...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only move the branch if nobody else did in the meantime
+	err = g.repo.Storer.CheckAndSetReference(plumbing.NewHashReference(ref.Name(), commitID), ref)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := g.repo.CommitObject(commitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the commit %s: %w", commitID.String(), err)
+	}
+
+	return commit, nil
+}
+
+// CommitChange applies the change to the board of the tip of the branch and commits the result. The change is applied
+// again on the new tip if another commit lands on the branch first, so commits to any branch can run in parallel.
+func (g *Game) CommitChange(branchID string, change BoardChange, player *model.Player) (*object.Commit, error) {
+	err := g.authorize(branchID, player, actionCommit)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		ref, err := g.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+		if err != nil {
+			return nil, gqlerrors.ErrBranchNotFound(branchID)
+		}
+		tip, err := g.repo.CommitObject(ref.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read commit %s: %w", ref.Hash().String(), err)
+		}
+		board, err := ReadBoard(tip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read board: %w", err)
+		}
+
+		message, err := change(board)
+		if err != nil {
+			return nil, err
+		}
+
+		commit, err := g.CommitBoard(ref, board, message, player)
+		if errors.Is(err, storage.ErrReferenceHasChanged) && attempt < maxCommitAttempts {
+			continue
+		}
+		if errors.Is(err, storage.ErrReferenceHasChanged) {
+			return nil, gqlerrors.ErrBranchChanged(branchID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to commit the board change: %w", err)
+		}
+
+		return commit, nil
+	}
+}
+
+// lockedStorer serializes the access to the storage of a game. Neither the memory nor the filesystem storage of
+// go-git are safe for concurrent use, but every access is short as objects are built before being stored.
+type lockedStorer struct {
+	storage.Storer
+
+	mu sync.RWMutex
+
+	// commits indexes the IDs of the commits by their abbreviation, to resolve abbreviated IDs without reading every commit
+	commits map[string][]plumbing.Hash
+}
+
+// newLockedStorer indexes the commits of the storage once, later commits are indexed as they are stored.
+func newLockedStorer(s storage.Storer) (*lockedStorer, error) {
+	iter, err := s.IterEncodedObjects(plumbing.CommitObject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the commits: %w", err)
+	}
+	locked := &lockedStorer{Storer: s, commits: make(map[string][]plumbing.Hash)}
+	err = iter.ForEach(func(obj plumbing.EncodedObject) error {
+		locked.indexCommit(obj.Hash())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to index the commits: %w", err)
+	}
+
+	return locked, nil
+}
+
+// ResolveCommit returns the ID of the only commit whose ID starts with the prefix, which is at least
+// abbreviatedCommitIDLength long.
+func (s *lockedStorer) ResolveCommit(prefix string) (plumbing.Hash, bool) {
+	if len(prefix) < abbreviatedCommitIDLength {
+		return plumbing.ZeroHash, false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Abbreviated IDs matching more than 1 commit are ambiguous
+	var result plumbing.Hash
+	matches := 0
+	for _, commitID := range s.commits[prefix[:abbreviatedCommitIDLength]] {
+		if strings.HasPrefix(commitID.String(), prefix) {
+			result = commitID
+			matches++
+		}
+	}
+
+	return result, matches == 1
+}
+
+func (s *lockedStorer) indexCommit(commitID plumbing.Hash) {
+	abbreviation := commitID.String()[:abbreviatedCommitIDLength]
+	for _, indexed := range s.commits[abbreviation] {
+		if indexed == commitID {
+			return
+		}
+	}
+	s.commits[abbreviation] = append(s.commits[abbreviation], commitID)
+}
+
+// Close releases the file handles of filesystem storages.
+func (s *lockedStorer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if closer, ok := s.Storer.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (s *lockedStorer) SetEncodedObject(obj plumbing.EncodedObject) (plumbing.Hash, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, err := s.Storer.SetEncodedObject(obj)
+	if err == nil && obj.Type() == plumbing.CommitObject {
+		s.indexCommit(h)
+	}
+	return h, err
+}
+
+// EncodedObject takes the write lock as reading objects fills the caches of the filesystem storage.
+func (s *lockedStorer) EncodedObject(t plumbing.ObjectType, h plumbing.Hash) (plumbing.EncodedObject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.EncodedObject(t, h)
+}
+
+func (s *lockedStorer) IterEncodedObjects(t plumbing.ObjectType) (storer.EncodedObjectIter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Read every object now, the iterator would otherwise read the storage unlocked
+	iter, err := s.Storer.IterEncodedObjects(t)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]plumbing.EncodedObject, 0)
+	err = iter.ForEach(func(obj plumbing.EncodedObject) error {
+		objects = append(objects, obj)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storer.NewEncodedObjectSliceIter(objects), nil
+}
+
+func (s *lockedStorer) HasEncodedObject(h plumbing.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.HasEncodedObject(h)
+}
+
+func (s *lockedStorer) EncodedObjectSize(h plumbing.Hash) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.EncodedObjectSize(h)
+}
+
+func (s *lockedStorer) SetReference(ref *plumbing.Reference) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.SetReference(ref)
+}
+
+// CheckAndSetReference also fails when the reference was removed since old was read. go-git only compares with a
+// reference that still exists, a branch deleted or renamed in the meantime would come back otherwise.
+func (s *lockedStorer) CheckAndSetReference(new, old *plumbing.Reference) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if old != nil {
+		_, err := s.Storer.Reference(old.Name())
+		if errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return storage.ErrReferenceHasChanged
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return s.Storer.CheckAndSetReference(new, old)
+}
+
+func (s *lockedStorer) Reference(name plumbing.ReferenceName) (*plumbing.Reference, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.Storer.Reference(name)
+}
+
+func (s *lockedStorer) IterReferences() (storer.ReferenceIter, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Read every reference now, the iterator would otherwise read the storage unlocked
+	iter, err := s.Storer.IterReferences()
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]*plumbing.Reference, 0)
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		refs = append(refs, ref)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storer.NewReferenceSliceIter(refs), nil
+}
+
+func (s *lockedStorer) RemoveReference(name plumbing.ReferenceName) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.RemoveReference(name)
+}
+
+func (s *lockedStorer) CountLooseRefs() (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.Storer.CountLooseRefs()
+}
+
+func (s *lockedStorer) PackRefs() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.PackRefs()
+}
+
+// Config is read and written under the lock too, as the rules of the branches are kept in the config.
+func (s *lockedStorer) Config() (*config.Config, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.Storer.Config()
+}
+
+func (s *lockedStorer) SetConfig(cfg *config.Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.SetConfig(cfg)
+}
+
+func (s *lockedStorer) Index() (*index.Index, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.Storer.Index()
+}
+
+func (s *lockedStorer) SetIndex(idx *index.Index) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.SetIndex(idx)
+}
+
+func (s *lockedStorer) Shallow() ([]plumbing.Hash, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.Storer.Shallow()
+}
+
+func (s *lockedStorer) SetShallow(commits []plumbing.Hash) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Storer.SetShallow(commits)
+}
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+func TestLockedStorer_CheckAndSetReference(t *testing.T) {
+	tests := []struct {
+		name string
+		// update changes the branch after the old reference was read
+		update   func(t *testing.T, game *Game)
+		expected bool
+		err      error
+	}{
+		{
+			name:     "Unchanged",
+			update:   func(t *testing.T, game *Game) {},
+			expected: true,
+		},
+		{
+			name: "Moved",
+			update: func(t *testing.T, game *Game) {
+				commit(t, game, "alice", alice, fill(0, 0, 5))
+			},
+			expected: true,
+			err:      storage.ErrReferenceHasChanged,
+		},
+		{
+			name: "Deleted",
+			update: func(t *testing.T, game *Game) {
+				_, err := game.DeleteBranch("alice", alice)
+				require.NoError(t, err, "delete")
+			},
+			err: storage.ErrReferenceHasChanged,
+		},
+		{
+			name: "Renamed",
+			update: func(t *testing.T, game *Game) {
+				_, err := game.RenameBranch("alice", "alice/renamed", alice)
+				require.NoError(t, err, "rename")
+			},
+			err: storage.ErrReferenceHasChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			ownBranch(t, game, "alice", alice, model.BranchProtection{})
+			old, err := game.repo.Reference(plumbing.NewBranchReferenceName("alice"), false)
+			r.NoError(err, "branch")
+			tt.update(t, game)
+
+			// Act
+			_, err = game.CommitBoard(old, tipBoard(t, game, masterBranch), "ADD_FILL 0 2 6", alice)
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+			current, err := game.repo.Reference(old.Name(), false)
+			if !tt.expected {
+				r.Equal(plumbing.ErrReferenceNotFound, err, "branch kept removed")
+				return
+			}
+			r.NoError(err, "branch")
+			if tt.err == nil {
+				r.NotEqual(old.Hash(), current.Hash(), "branch moved")
+			}
+		})
+	}
+}
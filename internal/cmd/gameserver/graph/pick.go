@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+// cellConflictError is returned when the cell a commit is cherry-picked or reverted on has changed since that commit.
+type cellConflictError struct {
+	conflict *model.CellConflict
+}
+
+func (e *cellConflictError) Error() string {
+	return fmt.Sprintf("cell [%d][%d] has changed since", e.conflict.Row, e.conflict.Col)
+}
+
+// cellCommit is a commit changing a single cell, with the cell before and after the commit.
+type cellCommit struct {
+	*object.Commit
+
+	change *model.Commit
+	before engine.Cell
+	after  engine.Cell
+}
+
+// readCellCommit reads a commit filling, erasing or noting a cell, the only commits which can be picked or reverted.
+func (g *Game) readCellCommit(commitID string) (*cellCommit, error) {
+	c, err := g.repo.CommitObject(plumbing.NewHash(commitID))
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(commitID)
+	}
+	change, err := ConvertCommit(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert commit: %w", err)
+	}
+	switch change.Type {
+	case model.CommitTypeAddFill, model.CommitTypeRemoveFill, model.CommitTypeToggleNote:
+	default:
+		return nil, gqlerrors.ErrInvalidInputCommitType(change.Type)
+	}
+
+	parent, err := c.Parent(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the parent of commit %s: %w", commitID, err)
+	}
+	before, err := ReadBoard(parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board: %w", err)
+	}
+	after, err := ReadBoard(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board: %w", err)
+	}
+
+	row, col := *change.Row, *change.Col
+	return &cellCommit{
+		Commit: c,
+		change: change,
+		before: before[row][col],
+		after:  after[row][col],
+	}, nil
+}
+
+// inverse returns the commit message undoing the commit. A fill or an erasure restores the value of the cell before
+// the commit, a note is toggled again.
+func (c *cellCommit) inverse() string {
+	row, col := *c.change.Row, *c.change.Col
+	switch c.change.Type {
+	case model.CommitTypeToggleNote:
+		return commitSummary(c.Message)
+
+	default:
+		if c.before.Value != 0 {
+			return fmt.Sprintf("%s %d %d %d", model.CommitTypeAddFill, row, col, c.before.Value)
+		}
+		return fmt.Sprintf("%s %d %d", model.CommitTypeRemoveFill, row, col)
+	}
+}
+
+// changed tells whether the part of the cell the commit changes differs from the expected cell.
+func (c *cellCommit) changed(expected, actual engine.Cell) bool {
+	if c.change.Type == model.CommitTypeToggleNote {
+		note := *c.change.Val - 1
+		return expected.Notes[note] != actual.Notes[note]
+	}
+
+	return expected.Value != actual.Value
+}
+
+// CherryPick commits the change of the commit again on top of the branch. The cell must be on the branch as it was
+// before the commit.
+func (g *Game) CherryPick(commitID, branchID string, player *model.Player) (*object.Commit, error) {
+	c, err := g.readCellCommit(commitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.commitCellChange(branchID, c, c.before, commitSummary(c.Message), player)
+}
+
+// Revert commits the inverse of the commit on top of the branch. The cell must be on the branch as it was after the
+// commit, the commits after it are kept.
+func (g *Game) Revert(commitID, branchID string, player *model.Player) (*object.Commit, error) {
+	c, err := g.readCellCommit(commitID)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.commitCellChange(branchID, c, c.after, c.inverse(), player)
+}
+
+func (g *Game) commitCellChange(branchID string, c *cellCommit, expected engine.Cell, message string, player *model.Player) (*object.Commit, error) {
+	row, col := *c.change.Row, *c.change.Col
+	return g.CommitChange(branchID, func(board engine.Board) (string, error) {
+		if c.changed(expected, board[row][col]) {
+			return "", &cellConflictError{conflict: &model.CellConflict{
+				Row:      row,
+				Col:      col,
+				CommitID: c.Hash.String(),
+				Expected: convertCellPtr(expected),
+				Actual:   convertCellPtr(board[row][col]),
+			}}
+		}
+
+		_, err := ApplyCommit(board, &object.Commit{Message: message})
+		if err != nil {
+			return "", err
+		}
+		return message, nil
+	}, player)
+}
+
+func convertCellPtr(cell engine.Cell) *model.Cell {
+	result := ConvertCell(cell)
+	return &result
+}
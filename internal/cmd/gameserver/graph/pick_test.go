@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+func TestGame_CherryPickAndRevert(t *testing.T) {
+	tests := []struct {
+		name string
+		// arrange commits on master and alice, branched off master first, and returns the commit picked or reverted
+		arrange  func(t *testing.T, game *Game) *object.Commit
+		revert   bool
+		expected int
+		conflict *model.CellConflict
+		err      error
+	}{
+		{
+			name: "CherryPick",
+			arrange: func(t *testing.T, game *Game) *object.Commit {
+				return commit(t, game, masterBranch, bob, fill(0, 0, 4))
+			},
+			expected: 4,
+		},
+		{
+			name: "CherryPickOnChangedCell",
+			arrange: func(t *testing.T, game *Game) *object.Commit {
+				commit(t, game, "alice", alice, fill(0, 0, 5))
+				return commit(t, game, masterBranch, bob, fill(0, 0, 4))
+			},
+			expected: 5,
+			conflict: &model.CellConflict{Expected: &model.Cell{Val: 0}, Actual: &model.Cell{Val: 5}},
+		},
+		{
+			name: "CherryPickInitialCommit",
+			arrange: func(t *testing.T, game *Game) *object.Commit {
+				initial, err := game.repo.CommitObject(tip(t, game, masterBranch))
+				require.NoError(t, err, "initial commit")
+				return initial
+			},
+			err: gqlerrors.ErrInvalidInputCommitType(model.CommitTypeInitial),
+		},
+		{
+			name: "RevertFill",
+			arrange: func(t *testing.T, game *Game) *object.Commit {
+				return commit(t, game, "alice", alice, fill(0, 0, 4))
+			},
+			revert:   true,
+			expected: 0,
+		},
+		{
+			name: "RevertOverwrite",
+			arrange: func(t *testing.T, game *Game) *object.Commit {
+				commit(t, game, "alice", alice, fill(0, 0, 4))
+				return commit(t, game, "alice", alice, fill(0, 0, 7))
+			},
+			revert:   true,
+			expected: 4,
+		},
+		{
+			name: "RevertOnChangedCell",
+			arrange: func(t *testing.T, game *Game) *object.Commit {
+				reverted := commit(t, game, "alice", alice, fill(0, 0, 4))
+				commit(t, game, "alice", alice, fill(0, 0, 7))
+				return reverted
+			},
+			revert:   true,
+			expected: 7,
+			conflict: &model.CellConflict{Expected: &model.Cell{Val: 4}, Actual: &model.Cell{Val: 7}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			addBranch(t, game, "alice", masterBranch)
+			c := tt.arrange(t, game)
+			before := tip(t, game, "alice")
+
+			// Act
+			var err error
+			if tt.revert {
+				_, err = game.Revert(c.Hash.String(), "alice", alice)
+			} else {
+				_, err = game.CherryPick(c.Hash.String(), "alice", alice)
+			}
+
+			// Assert
+			if tt.err != nil {
+				r.Equal(tt.err, err, "err")
+				r.Equal(before, tip(t, game, "alice"), "tip")
+				return
+			}
+			var conflict *cellConflictError
+			if tt.conflict != nil {
+				r.True(errors.As(err, &conflict), "conflict")
+				r.Equal(0, conflict.conflict.Row, "row")
+				r.Equal(0, conflict.conflict.Col, "col")
+				r.Equal(c.Hash.String(), conflict.conflict.CommitID, "commit")
+				r.Equal(tt.conflict.Expected.Val, conflict.conflict.Expected.Val, "expected")
+				r.Equal(tt.conflict.Actual.Val, conflict.conflict.Actual.Val, "actual")
+				r.Equal(before, tip(t, game, "alice"), "tip")
+			} else {
+				r.NoError(err, "err")
+				r.NotEqual(before, tip(t, game, "alice"), "tip")
+			}
+			r.Equal(tt.expected, tipBoard(t, game, "alice")[0][0].Value, "cell")
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+const maxDisplayNameLength = 32
+
+// playerColors are handed out to new players in turn, players can pick any other color afterwards
+var playerColors = []string{
+	"#e6194b",
+	"#3cb44b",
+	"#4363d8",
+	"#f58231",
+	"#911eb4",
+	"#42d4f4",
+	"#f032e6",
+	"#9a6324",
+}
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// validateDisplayName normalizes the name and checks that no other player of the game uses it, ignoring the case.
+func (g *Game) validateDisplayName(playerID, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxDisplayNameLength {
+		return "", gqlerrors.ErrInvalidDisplayName(maxDisplayNameLength)
+	}
+
+	for _, player := range g.players {
+		if player.ID != playerID && strings.EqualFold(player.DisplayName, name) {
+			return "", gqlerrors.ErrDisplayNameTaken(name)
+		}
+	}
+
+	return name, nil
+}
+
+func validateColor(color string) (string, error) {
+	if !colorPattern.MatchString(color) {
+		return "", gqlerrors.ErrInvalidColor(color)
+	}
+
+	return strings.ToLower(color), nil
+}
+
+// nextColor returns the first color no player uses yet.
+func (g *Game) nextColor() string {
+	used := make(map[string]struct{}, len(g.players))
+	for _, player := range g.players {
+		used[player.Color] = struct{}{}
+	}
+	for _, color := range playerColors {
+		if _, ok := used[color]; !ok {
+			return color
+		}
+	}
+
+	return playerColors[len(g.players)%len(playerColors)]
+}
+
+// NotifyPlayersChanged publishes the new state of the game with the change of the player.
+func (g *Game) NotifyPlayersChanged(eventType model.PlayerEventType, player *model.Player) {
+	g.publishSnapshot()
+	event := &model.PlayersChangedEvent{
+		Type:    eventType,
+		Player:  player,
+		Players: g.snapshot().playerList,
+	}
+	g.events.Publish(playersTopic, event)
+}
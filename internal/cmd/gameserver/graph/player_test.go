@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+func TestMutationResolver_UpdatePlayer(t *testing.T) {
+	tests := []struct {
+		name string
+		// input changes the player, other is another player of the game
+		input    func(player, other *model.Player) model.UpdatePlayerInput
+		expected func(player, other *model.Player) model.Player
+		err      func(player, other *model.Player) error
+	}{
+		{
+			name: "DisplayName",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, DisplayName: StringPtr("  Alice ")}
+			},
+			expected: func(player, other *model.Player) model.Player {
+				return model.Player{ID: player.ID, DisplayName: "Alice", Color: player.Color}
+			},
+		},
+		{
+			name: "OwnDisplayNameOtherCase",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, DisplayName: StringPtr(strings.ToUpper(player.DisplayName))}
+			},
+			expected: func(player, other *model.Player) model.Player {
+				return model.Player{ID: player.ID, DisplayName: strings.ToUpper(player.DisplayName), Color: player.Color}
+			},
+		},
+		{
+			name: "DisplayNameTaken",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, DisplayName: StringPtr(strings.ToUpper(other.DisplayName))}
+			},
+			err: func(player, other *model.Player) error {
+				return gqlerrors.ErrDisplayNameTaken(strings.ToUpper(other.DisplayName))
+			},
+		},
+		{
+			name: "EmptyDisplayName",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, DisplayName: StringPtr("   ")}
+			},
+			err: func(player, other *model.Player) error {
+				return gqlerrors.ErrInvalidDisplayName(maxDisplayNameLength)
+			},
+		},
+		{
+			name: "DisplayNameTooLong",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, DisplayName: StringPtr(strings.Repeat("é", maxDisplayNameLength+1))}
+			},
+			err: func(player, other *model.Player) error {
+				return gqlerrors.ErrInvalidDisplayName(maxDisplayNameLength)
+			},
+		},
+		{
+			name: "Color",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, Color: StringPtr("#ABCDEF")}
+			},
+			expected: func(player, other *model.Player) model.Player {
+				return model.Player{ID: player.ID, DisplayName: player.DisplayName, Color: "#abcdef"}
+			},
+		},
+		{
+			name: "InvalidColor",
+			input: func(player, other *model.Player) model.UpdatePlayerInput {
+				return model.UpdatePlayerInput{GameID: DefaultGameID, Color: StringPtr("red")}
+			},
+			err: func(player, other *model.Player) error {
+				return gqlerrors.ErrInvalidColor("red")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			res, game := newTestResolver(t)
+			ctx, player := join(t, res)
+			_, other := join(t, res)
+
+			// Act
+			payload, err := (&mutationResolver{res}).UpdatePlayer(ctx, tt.input(player, other))
+
+			// Assert
+			if tt.err != nil {
+				r.Equal(tt.err(player, other), err, "err")
+				r.Equal(player, game.snapshot().players[player.ID], "player unchanged")
+				return
+			}
+			r.NoError(err, "update")
+			expected := tt.expected(player, other)
+			r.Equal(&expected, payload.Player, "player")
+			r.Equal(&expected, game.snapshot().players[player.ID], "player of the game")
+		})
+	}
+}
+
+func TestMutationResolver_Leave(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, a player with a presence and a branch only it commits on
+	res, game := newTestResolver(t)
+	ctx, player := join(t, res)
+	otherCtx, other := join(t, res)
+	ownBranch(t, game, "alice", player, model.BranchProtection{OwnerOnly: true, NoForceReset: true})
+	ownBranch(t, game, "bob", other, model.BranchProtection{OwnerOnly: true})
+	setCursor(t, res, ctx, masterBranch, 1, 2)
+
+	// Act
+	payload, err := (&mutationResolver{res}).Leave(ctx, DefaultGameID)
+
+	// Assert
+	r.NoError(err, "leave")
+	r.Equal(player, payload.Player, "player")
+	r.NotContains(game.snapshot().players, player.ID, "players")
+	err = game.do(func() error {
+		r.NotContains(game.presences, player.ID, "presence")
+		return nil
+	})
+	r.NoError(err, "presence")
+	_, err = (&mutationResolver{res}).UpdatePlayer(ctx, model.UpdatePlayerInput{GameID: DefaultGameID, Color: StringPtr("#000000")})
+	r.Equal(gqlerrors.ErrNotJoined(DefaultGameID), err, "player gone")
+
+	// The name is free again and the branch is released, without locking it
+	_, err = (&mutationResolver{res}).UpdatePlayer(otherCtx, model.UpdatePlayerInput{GameID: DefaultGameID, DisplayName: StringPtr(player.DisplayName)})
+	r.NoError(err, "take the name")
+	owner, protection := game.BranchRules("alice")
+	r.Empty(owner, "owner")
+	r.Equal(model.BranchProtection{NoForceReset: true, RequiredApprovals: defaultRequiredApprovals}, protection, "protection")
+	r.NoError(game.authorize("alice", other, actionCommit), "commit on the released branch")
+	owner, _ = game.BranchRules("bob")
+	r.Equal(other.ID, owner, "branch of another player")
+
+	// The rules are saved for the next start
+	rules, err := readBranchRules(game.repo)
+	r.NoError(err, "read rules")
+	r.Equal(&branchRules{BranchProtection: model.BranchProtection{NoForceReset: true}}, rules["alice"], "saved rules")
+}
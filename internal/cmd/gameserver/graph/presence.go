@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+const (
+	// presenceIdleAfter is how long a player goes without moving the cursor before being shown as idle
+	presenceIdleAfter = time.Minute
+
+	// presenceTimeout is how long the presence of a player without a presence subscription is kept
+	presenceTimeout = 30 * time.Second
+)
+
+// presence is the ephemeral state of a player's cursor, never committed to the repo.
+type presence struct {
+	*model.Presence
+
+	// connections is the number of presence subscriptions of the player, the presence expires without any
+	connections int
+	lastSeen    time.Time
+
+	idleTimer   *time.Timer
+	expireTimer *time.Timer
+}
+
+// SetCursor moves the cursor of the player and notifies the subscribers of both the previous and the new branch.
+func (g *Game) SetCursor(player *model.Player, branchID string, row, col int) *model.Presence {
+	p := g.presenceOf(player.ID)
+	previousBranchID := ""
+	if p.Presence != nil {
+		previousBranchID = p.BranchID
+	}
+
+	p.Presence = &model.Presence{
+		Player:    player,
+		BranchID:  branchID,
+		Row:       row,
+		Col:       col,
+		Online:    true,
+		UpdatedAt: time.Now(),
+	}
+	p.lastSeen = p.UpdatedAt
+	p.idleTimer.Stop()
+	p.idleTimer.Reset(presenceIdleAfter)
+	scheduleExpiry(p)
+
+	if previousBranchID != "" && previousBranchID != branchID {
+		g.notifyPresence(previousBranchID, p.Presence)
+	}
+	g.notifyPresence(branchID, p.Presence)
+
+	return p.Presence
+}
+
+// presenceReplay replays the presence of every player on the branch, before the updates of the players.
+func (g *Game) presenceReplay(branchID string) *replay {
+	return &replay{
+		events: func() ([]interface{}, error) {
+			events := make([]interface{}, 0)
+			err := g.do(func() error {
+				for _, p := range g.presences {
+					if p.Presence != nil && p.BranchID == branchID {
+						events = append(events, p.Presence)
+					}
+				}
+				return nil
+			})
+			return events, err
+		},
+		id: func(event interface{}) string {
+			p := event.(*model.Presence)
+			return p.Player.ID + "@" + p.UpdatedAt.String()
+		},
+	}
+}
+
+// ConnectPresence keeps the presence of the player for as long as it has a presence subscription, until
+// DisconnectPresence is called as many times.
+func (g *Game) ConnectPresence(playerID string) {
+	p := g.presenceOf(playerID)
+	p.connections++
+	scheduleExpiry(p)
+}
+
+// DisconnectPresence lets the presence of the player expire after presenceTimeout once it has no subscription left.
+func (g *Game) DisconnectPresence(playerID string) {
+	p, ok := g.presences[playerID]
+	if !ok {
+		return
+	}
+
+	p.connections--
+	p.lastSeen = time.Now()
+	scheduleExpiry(p)
+}
+
+// RemovePresence drops the presence of the player and lets the subscribers know it went offline.
+func (g *Game) RemovePresence(playerID string) {
+	p, ok := g.presences[playerID]
+	if !ok {
+		return
+	}
+
+	p.idleTimer.Stop()
+	p.expireTimer.Stop()
+	delete(g.presences, playerID)
+	if p.Presence == nil {
+		return
+	}
+
+	offline := *p.Presence
+	offline.Online = false
+	offline.UpdatedAt = time.Now()
+	g.notifyPresence(offline.BranchID, &offline)
+}
+
+func (g *Game) presenceOf(playerID string) *presence {
+	p, ok := g.presences[playerID]
+	if !ok {
+		p = &presence{
+			lastSeen:    time.Now(),
+			idleTimer:   time.AfterFunc(presenceIdleAfter, func() { g.do(func() error { g.markIdle(playerID); return nil }) }),
+			expireTimer: time.AfterFunc(presenceTimeout, func() { g.do(func() error { g.expirePresence(playerID); return nil }) }),
+		}
+		g.presences[playerID] = p
+	}
+
+	return p
+}
+
+// scheduleExpiry keeps the presence while the player has a subscription, otherwise it expires after presenceTimeout.
+func scheduleExpiry(p *presence) {
+	p.expireTimer.Stop()
+	if p.connections <= 0 {
+		p.expireTimer.Reset(presenceTimeout)
+	}
+}
+
+func (g *Game) markIdle(playerID string) {
+	p, ok := g.presences[playerID]
+	// The timer may have fired right before the cursor moved again
+	if !ok || p.Presence == nil || p.Idle || time.Since(p.UpdatedAt) < presenceIdleAfter {
+		return
+	}
+
+	idle := *p.Presence
+	idle.Idle = true
+	idle.UpdatedAt = time.Now()
+	p.Presence = &idle
+	g.notifyPresence(idle.BranchID, &idle)
+}
+
+func (g *Game) expirePresence(playerID string) {
+	p, ok := g.presences[playerID]
+	if !ok || p.connections > 0 || time.Since(p.lastSeen) < presenceTimeout {
+		return
+	}
+	g.RemovePresence(playerID)
+}
+
+// notifyPresence publishes the update to the subscribers of the branch. Presence is lossy by nature, it goes through
+// the event bus of the game like the other events, so that subscribers falling behind never block the game.
+func (g *Game) notifyPresence(branchID string, update *model.Presence) {
+	g.events.Publish(presenceTopic(branchID), update)
+}
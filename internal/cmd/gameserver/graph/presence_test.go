@@ -0,0 +1,212 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// subscribePresence subscribes to the presence on the branch with the context of a player, or anonymously with
+// context.Background(). The subscription ends with the test.
+func subscribePresence(t *testing.T, res *Resolver, ctx context.Context, branchID string) (<-chan *model.Presence, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+	presences, err := (&subscriptionResolver{res}).Presence(ctx, DefaultGameID, branchID)
+	require.NoError(t, err, "subscribe to %s", branchID)
+
+	return presences, cancel
+}
+
+// setCursor moves the cursor of the player of ctx on the branch.
+func setCursor(t *testing.T, res *Resolver, ctx context.Context, branchID string, row, col int) {
+	_, err := (&mutationResolver{res}).SetCursor(ctx, model.SetCursorInput{GameID: DefaultGameID, BranchID: branchID, Row: row, Col: col})
+	require.NoError(t, err, "set cursor on %s", branchID)
+}
+
+// receivePresence waits for the next presence update.
+func receivePresence(t *testing.T, presences <-chan *model.Presence) *model.Presence {
+	select {
+	case p := <-presences:
+		require.NotNil(t, p, "presence")
+		return p
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no presence update")
+		return nil
+	}
+}
+
+func TestSubscriptionResolver_Presence_StartWithPlayersOnBranch(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	res, game := newTestResolver(t)
+	addBranch(t, game, "alice", masterBranch)
+	ctx, player := join(t, res)
+	setCursor(t, res, ctx, masterBranch, 1, 2)
+
+	// Act
+	presences, _ := subscribePresence(t, res, context.Background(), masterBranch)
+
+	// Assert
+	p := receivePresence(t, presences)
+	r.Equal(player.ID, p.Player.ID, "player")
+	r.Equal(1, p.Row, "row")
+	r.Equal(2, p.Col, "col")
+	r.True(p.Online, "online")
+}
+
+func TestSubscriptionResolver_Presence_SwitchBranch_NotifyBothBranches(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	res, game := newTestResolver(t)
+	addBranch(t, game, "alice", masterBranch)
+	ctx, player := join(t, res)
+	master, _ := subscribePresence(t, res, context.Background(), masterBranch)
+	aliceBranch, _ := subscribePresence(t, res, context.Background(), "alice")
+	setCursor(t, res, ctx, masterBranch, 1, 2)
+	r.Equal(masterBranch, receivePresence(t, master).BranchID, "on master")
+
+	// Act
+	setCursor(t, res, ctx, "alice", 3, 4)
+
+	// Assert, master sees the player leave to the other branch, which sees it arrive
+	left := receivePresence(t, master)
+	r.Equal(player.ID, left.Player.ID, "player left")
+	r.Equal("alice", left.BranchID, "branch left for")
+	arrived := receivePresence(t, aliceBranch)
+	r.Equal(player.ID, arrived.Player.ID, "player arrived")
+	r.Equal("alice", arrived.BranchID, "branch arrived on")
+	r.Equal(3, arrived.Row, "row")
+	r.Equal(4, arrived.Col, "col")
+}
+
+func TestGame_MarkIdle(t *testing.T) {
+	tests := []struct {
+		name string
+		// since is how long ago the cursor moved
+		since    time.Duration
+		expected bool
+	}{
+		{
+			name:     "CursorMovedRecently",
+			since:    presenceIdleAfter / 2,
+			expected: false,
+		},
+		{
+			name:     "CursorStill",
+			since:    presenceIdleAfter,
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			res, game := newTestResolver(t)
+			ctx, player := join(t, res)
+			presences, _ := subscribePresence(t, res, context.Background(), masterBranch)
+			setCursor(t, res, ctx, masterBranch, 1, 2)
+			receivePresence(t, presences)
+
+			// Act, as the idle timer does
+			var idle bool
+			err := game.do(func() error {
+				p := game.presences[player.ID]
+				moved := *p.Presence
+				moved.UpdatedAt = time.Now().Add(-tt.since)
+				p.Presence = &moved
+				game.markIdle(player.ID)
+				idle = game.presences[player.ID].Idle
+				return nil
+			})
+
+			// Assert
+			r.NoError(err, "mark idle")
+			r.Equal(tt.expected, idle, "idle")
+			if tt.expected {
+				p := receivePresence(t, presences)
+				r.True(p.Idle, "idle sent")
+				r.True(p.Online, "still online")
+			}
+		})
+	}
+}
+
+func TestGame_ExpirePresence(t *testing.T) {
+	tests := []struct {
+		name string
+		// subscribe subscribes the player to the presence on master with its ctx, if needed
+		subscribe func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player)
+		expected  bool
+	}{
+		{
+			name:      "WithoutSubscription",
+			subscribe: func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) {},
+			expected:  false,
+		},
+		{
+			name: "WithSubscription",
+			subscribe: func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) {
+				subscribePresence(t, res, ctx, masterBranch)
+			},
+			expected: true,
+		},
+		{
+			name: "SubscriptionClosed",
+			subscribe: func(t *testing.T, res *Resolver, ctx context.Context, player *model.Player) {
+				_, cancel := subscribePresence(t, res, ctx, masterBranch)
+				cancel()
+				game, err := res.getGame(DefaultGameID)
+				require.NoError(t, err, "game")
+				require.Eventually(t, func() bool {
+					var connections int
+					_ = game.do(func() error {
+						connections = game.presences[player.ID].connections
+						return nil
+					})
+					return connections == 0
+				}, 5*time.Second, 10*time.Millisecond, "disconnected")
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange, a player whose last subscription was gone for long enough
+			res, game := newTestResolver(t)
+			ctx, player := join(t, res)
+			presences, _ := subscribePresence(t, res, context.Background(), masterBranch)
+			setCursor(t, res, ctx, masterBranch, 1, 2)
+			receivePresence(t, presences)
+			tt.subscribe(t, res, ctx, player)
+
+			// Act, as the expiry timer does
+			var kept bool
+			err := game.do(func() error {
+				game.presences[player.ID].lastSeen = time.Now().Add(-presenceTimeout)
+				game.expirePresence(player.ID)
+				_, kept = game.presences[player.ID]
+				return nil
+			})
+
+			// Assert
+			r.NoError(err, "expire presence")
+			r.Equal(tt.expected, kept, "presence kept")
+			if !tt.expected {
+				p := receivePresence(t, presences)
+				r.Equal(player.ID, p.Player.ID, "player")
+				r.False(p.Online, "offline sent")
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// maxProposalTitleLength is the longest proposal title in characters
+const maxProposalTitleLength = 200
+
+// OpenProposal proposes to merge the target branch into the source branch, with the diff of the merge as of now. The
+// branches are merged once enough players approved the proposal.
+func (g *Game) OpenProposal(input model.OpenProposalInput, player *model.Player) (*model.Proposal, error) {
+	if input.SourceBranchID == input.TargetBranchID {
+		return nil, gqlerrors.ErrSameBranch(input.SourceBranchID)
+	}
+	title := strings.TrimSpace(stringValue(input.Title))
+	if title == "" {
+		title = fmt.Sprintf("Merge %s into %s", input.TargetBranchID, input.SourceBranchID)
+	}
+	if utf8.RuneCountInString(title) > maxProposalTitleLength {
+		return nil, gqlerrors.ErrInvalidProposalTitle(maxProposalTitleLength)
+	}
+	err := g.authorize(input.SourceBranchID, player, actionApprovedMerge)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy := model.MergeStrategyReplay
+	if input.Strategy != nil {
+		strategy = *input.Strategy
+	}
+	diff, err := g.PreviewMerge(input.SourceBranchID, input.TargetBranchID, mergeStrategy(&strategy))
+	if err != nil {
+		return nil, err
+	}
+	_, protection := g.BranchRules(input.SourceBranchID)
+
+	proposal := &model.Proposal{
+		ID:             uuid.NewString(),
+		SourceBranchID: input.SourceBranchID,
+		TargetBranchID: input.TargetBranchID,
+		Title:          title,
+		Author:         player,
+		Strategy:       strategy,
+		Status:         model.ProposalStatusOpen,
+		Diff:           diff,
+		Comments:       make([]*model.ProposalComment, 0),
+		Votes:          make([]*model.ProposalVote, 0),
+		Threshold:      protection.RequiredApprovals,
+		CreatedAt:      time.Now(),
+	}
+	err = g.do(func() error {
+		g.proposals[proposal.ID] = proposal
+		g.publishSnapshot()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.notifyProposalEvent(model.ProposalEventTypeOpened, proposal, player, nil)
+
+	return proposal, nil
+}
+
+// CommentProposal adds a comment of the player to the proposal.
+func (g *Game) CommentProposal(proposalID, text string, player *model.Player) (*model.Proposal, *model.ProposalComment, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > maxMessageLength {
+		return nil, nil, gqlerrors.ErrInvalidMessage(maxMessageLength)
+	}
+
+	comment := &model.ProposalComment{
+		ID:        uuid.NewString(),
+		Author:    player,
+		Text:      text,
+		CreatedAt: time.Now(),
+	}
+	var proposal *model.Proposal
+	err := g.do(func() error {
+		var err error
+		proposal, err = g.updateProposal(proposalID, func(p *model.Proposal) error {
+			p.Comments = append(p.Comments, comment)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	g.notifyProposalEvent(model.ProposalEventTypeCommented, proposal, player, comment)
+
+	return proposal, comment, nil
+}
+
+// VoteProposal records the vote of the player on the open proposal, replacing the previous vote of the player. The
+// proposal is rejected once rejected by as many players as its threshold, and merged once approved by as many.
+func (g *Game) VoteProposal(proposalID string, voteType model.ProposalVoteType, player *model.Player) (*model.Proposal, error) {
+	var proposal *model.Proposal
+	err := g.do(func() error {
+		var err error
+		proposal, err = g.updateProposal(proposalID, func(p *model.Proposal) error {
+			if p.Status != model.ProposalStatusOpen {
+				return gqlerrors.ErrProposalNotOpen(proposalID)
+			}
+			if p.Author.ID == player.ID {
+				return gqlerrors.ErrOwnProposal(proposalID)
+			}
+
+			vote := &model.ProposalVote{Player: player, Type: voteType, CreatedAt: time.Now()}
+			replaced := false
+			for i, v := range p.Votes {
+				if v.Player.ID == player.ID {
+					p.Votes[i] = vote
+					replaced = true
+				}
+			}
+			if !replaced {
+				p.Votes = append(p.Votes, vote)
+			}
+
+			// Only the vote reaching the threshold merges the proposal, as it isn't open anymore for the next votes
+			approvals, rejections := countVotes(p.Votes)
+			switch {
+			case rejections >= p.Threshold:
+				p.Status = model.ProposalStatusRejected
+			case approvals >= p.Threshold:
+				p.Status = model.ProposalStatusApproved
+			}
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.notifyProposalEvent(model.ProposalEventTypeVoted, proposal, player, nil)
+
+	switch proposal.Status {
+	case model.ProposalStatusRejected:
+		g.notifyProposalEvent(model.ProposalEventTypeRejected, proposal, player, nil)
+	case model.ProposalStatusApproved:
+		return g.mergeProposal(proposal, player)
+	}
+
+	return proposal, nil
+}
+
+// mergeProposal merges the branches of the approved proposal on behalf of its author. A merge with conflicts waits
+// for them to be resolved with resolveMerge. The proposal is open again if the merge fails, the next vote tries again.
+func (g *Game) mergeProposal(proposal *model.Proposal, player *model.Player) (*model.Proposal, error) {
+	payload, err := g.MergeBranch(proposal.SourceBranchID, proposal.TargetBranchID, mergeStrategy(&proposal.Strategy), proposal.Author, proposal.ID)
+	if err != nil {
+		reopenErr := g.do(func() error {
+			_, err := g.updateProposal(proposal.ID, func(p *model.Proposal) error {
+				p.Status = model.ProposalStatusOpen
+				return nil
+			})
+			return err
+		})
+		if reopenErr != nil {
+			g.Warn("Failed to reopen proposal.", zap.String("proposalId", proposal.ID), zap.Error(reopenErr))
+		}
+		return nil, err
+	}
+
+	if payload.PendingMerge == nil {
+		return g.completeProposal(proposal.ID, payload.SourceBranch.CommitID, player)
+	}
+
+	err = g.do(func() error {
+		var err error
+		proposal, err = g.updateProposal(proposal.ID, func(p *model.Proposal) error {
+			p.PendingMerge = payload.PendingMerge
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.notifyProposalEvent(model.ProposalEventTypeApproved, proposal, player, nil)
+
+	return proposal, nil
+}
+
+// completeProposal marks the proposal as merged, the commit being the tip of the source branch after the merge.
+func (g *Game) completeProposal(proposalID, commitID string, player *model.Player) (*model.Proposal, error) {
+	var proposal *model.Proposal
+	err := g.do(func() error {
+		var err error
+		proposal, err = g.updateProposal(proposalID, func(p *model.Proposal) error {
+			p.Status = model.ProposalStatusMerged
+			p.PendingMerge = nil
+			p.CommitID = StringPtr(commitID)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.notifyProposalEvent(model.ProposalEventTypeMerged, proposal, player, nil)
+
+	return proposal, nil
+}
+
+// updateProposal publishes a copy of the proposal with the update applied, only called by the goroutine of the game.
+// Published proposals are never changed, so that they are read from any goroutine.
+func (g *Game) updateProposal(proposalID string, update func(p *model.Proposal) error) (*model.Proposal, error) {
+	current, ok := g.proposals[proposalID]
+	if !ok {
+		return nil, gqlerrors.ErrProposalNotFound(proposalID)
+	}
+
+	proposal := *current
+	proposal.Comments = append([]*model.ProposalComment(nil), current.Comments...)
+	proposal.Votes = append([]*model.ProposalVote(nil), current.Votes...)
+	err := update(&proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	g.proposals[proposalID] = &proposal
+	g.publishSnapshot()
+
+	return &proposal, nil
+}
+
+// Proposals returns the proposals with the status, or every one if status is nil, oldest first.
+func (g *Game) Proposals(status *model.ProposalStatus) []*model.Proposal {
+	result := make([]*model.Proposal, 0)
+	for _, proposal := range g.snapshot().proposals {
+		if status == nil || proposal.Status == *status {
+			result = append(result, proposal)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return result
+}
+
+func (g *Game) Proposal(proposalID string) (*model.Proposal, error) {
+	proposal, ok := g.snapshot().proposals[proposalID]
+	if !ok {
+		return nil, gqlerrors.ErrProposalNotFound(proposalID)
+	}
+
+	return proposal, nil
+}
+
+func (g *Game) notifyProposalEvent(eventType model.ProposalEventType, proposal *model.Proposal, player *model.Player, comment *model.ProposalComment) {
+	g.events.Publish(proposalsTopic, &model.ProposalEvent{
+		Type:      eventType,
+		Proposal:  proposal,
+		Player:    player,
+		Comment:   comment,
+		Timestamp: time.Now(),
+	})
+}
+
+func countVotes(votes []*model.ProposalVote) (approvals, rejections int) {
+	for _, vote := range votes {
+		switch vote.Type {
+		case model.ProposalVoteTypeApprove:
+			approvals++
+		case model.ProposalVoteTypeReject:
+			rejections++
+		}
+	}
+
+	return approvals, rejections
+}
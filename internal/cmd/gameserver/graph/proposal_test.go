@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/merge"
+)
+
+var carol = &model.Player{ID: "carol", DisplayName: "Carol"}
+
+// proposalVote is a vote of a player on the proposal.
+type proposalVote struct {
+	player   *model.Player
+	voteType model.ProposalVoteType
+}
+
+// openTestProposal opens the proposal of alice to merge master into the branch of alice.
+func openTestProposal(t *testing.T, game *Game) *model.Proposal {
+	proposal, err := game.OpenProposal(model.OpenProposalInput{SourceBranchID: "alice", TargetBranchID: masterBranch}, alice)
+	require.NoError(t, err, "open proposal")
+
+	return proposal
+}
+
+func TestGame_VoteProposal(t *testing.T) {
+	approve := func(player *model.Player) proposalVote {
+		return proposalVote{player: player, voteType: model.ProposalVoteTypeApprove}
+	}
+	reject := func(player *model.Player) proposalVote {
+		return proposalVote{player: player, voteType: model.ProposalVoteTypeReject}
+	}
+
+	tests := []struct {
+		name              string
+		requiredApprovals int
+		votes             []proposalVote
+		expected          model.ProposalStatus
+		// approvals and rejections are the votes counted after the last vote
+		approvals, rejections int
+		// err returns the error of the last vote
+		err func(proposalID string) error
+	}{
+		{
+			name:              "DefaultThreshold_Approved",
+			requiredApprovals: 0,
+			votes:             []proposalVote{approve(bob)},
+			expected:          model.ProposalStatusMerged,
+			approvals:         1,
+		},
+		{
+			name:              "DefaultThreshold_Rejected",
+			requiredApprovals: 0,
+			votes:             []proposalVote{reject(bob)},
+			expected:          model.ProposalStatusRejected,
+			rejections:        1,
+		},
+		{
+			name:              "BelowThreshold",
+			requiredApprovals: 2,
+			votes:             []proposalVote{approve(bob)},
+			expected:          model.ProposalStatusOpen,
+			approvals:         1,
+		},
+		{
+			name:              "ReachThreshold",
+			requiredApprovals: 2,
+			votes:             []proposalVote{approve(bob), approve(carol)},
+			expected:          model.ProposalStatusMerged,
+			approvals:         2,
+		},
+		{
+			name:              "SplitVotes",
+			requiredApprovals: 2,
+			votes:             []proposalVote{approve(bob), reject(carol)},
+			expected:          model.ProposalStatusOpen,
+			approvals:         1,
+			rejections:        1,
+		},
+		{
+			name:              "SameVoteTwice_CountOnce",
+			requiredApprovals: 2,
+			votes:             []proposalVote{approve(bob), approve(bob)},
+			expected:          model.ProposalStatusOpen,
+			approvals:         1,
+		},
+		{
+			name:              "ChangedVote_ReplacePrevious",
+			requiredApprovals: 2,
+			votes:             []proposalVote{reject(bob), reject(bob), approve(bob), approve(carol)},
+			expected:          model.ProposalStatusMerged,
+			approvals:         2,
+		},
+		{
+			name:              "OwnProposal",
+			requiredApprovals: 1,
+			votes:             []proposalVote{approve(alice)},
+			expected:          model.ProposalStatusOpen,
+			err:               gqlerrors.ErrOwnProposal,
+		},
+		{
+			name:              "Rejected_NotOpen",
+			requiredApprovals: 1,
+			votes:             []proposalVote{reject(bob), approve(carol)},
+			expected:          model.ProposalStatusRejected,
+			rejections:        1,
+			err:               gqlerrors.ErrProposalNotOpen,
+		},
+		{
+			name:              "Merged_NotOpen",
+			requiredApprovals: 1,
+			votes:             []proposalVote{approve(bob), reject(carol)},
+			expected:          model.ProposalStatusMerged,
+			approvals:         1,
+			err:               gqlerrors.ErrProposalNotOpen,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			ownBranch(t, game, "alice", alice, model.BranchProtection{RequiredApprovals: tt.requiredApprovals})
+			commit(t, game, masterBranch, bob, fill(0, 0, 4))
+			proposal := openTestProposal(t, game)
+			sourceID := tip(t, game, "alice")
+
+			// Act
+			var err error
+			for i, vote := range tt.votes {
+				_, err = game.VoteProposal(proposal.ID, vote.voteType, vote.player)
+				if i < len(tt.votes)-1 {
+					r.NoError(err, "vote %d", i)
+				}
+			}
+
+			// Assert
+			if tt.err != nil {
+				r.Equal(tt.err(proposal.ID), err, "err")
+			} else {
+				r.NoError(err, "err")
+			}
+			proposal, err = game.Proposal(proposal.ID)
+			r.NoError(err, "proposal")
+			r.Equal(tt.expected, proposal.Status, "status")
+			expectedThreshold := tt.requiredApprovals
+			if expectedThreshold == 0 {
+				expectedThreshold = defaultRequiredApprovals
+			}
+			r.Equal(expectedThreshold, proposal.Threshold, "threshold")
+			approvals, rejections := countVotes(proposal.Votes)
+			r.Equal(tt.approvals, approvals, "approvals")
+			r.Equal(tt.rejections, rejections, "rejections")
+			if tt.expected != model.ProposalStatusMerged {
+				r.Nil(proposal.CommitID, "commit")
+				r.Equal(sourceID, tip(t, game, "alice"), "source untouched")
+				return
+			}
+			r.NotNil(proposal.CommitID, "commit")
+			r.Equal(tip(t, game, "alice").String(), *proposal.CommitID, "tip of source")
+			r.Equal(4, tipBoard(t, game, "alice")[0][0].Value, "merged")
+		})
+	}
+}
+
+func TestGame_VoteProposal_ConflictingCells_KeepPendingMerge(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	ownBranch(t, game, "alice", alice, model.BranchProtection{})
+	commit(t, game, masterBranch, bob, fill(0, 0, 4))
+	commit(t, game, "alice", alice, fill(0, 0, 5))
+	proposal := openTestProposal(t, game)
+	sourceID := tip(t, game, "alice")
+
+	// Act
+	proposal, err := game.VoteProposal(proposal.ID, model.ProposalVoteTypeApprove, bob)
+
+	// Assert
+	r.NoError(err, "vote")
+	r.Equal(model.ProposalStatusApproved, proposal.Status, "status")
+	r.Nil(proposal.CommitID, "commit")
+	r.NotNil(proposal.PendingMerge, "pending merge")
+	r.Contains(game.merges, proposal.PendingMerge.ID, "kept")
+	r.Equal(sourceID, tip(t, game, "alice"), "source untouched")
+}
+
+func TestGame_MergeBranch_IntoMaster_RequireProposal(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	game := newTestGame(t)
+	ownBranch(t, game, "alice", alice, model.BranchProtection{})
+	commit(t, game, "alice", alice, fill(0, 0, 5))
+	masterID := tip(t, game, masterBranch)
+
+	// Act
+	_, directErr := game.MergeBranch(masterBranch, "alice", merge.Replay{}, alice, "")
+	proposal, err := game.OpenProposal(model.OpenProposalInput{SourceBranchID: masterBranch, TargetBranchID: "alice"}, alice)
+	r.NoError(err, "open proposal")
+	r.Equal(masterID, tip(t, game, masterBranch), "master untouched")
+	proposal, err = game.VoteProposal(proposal.ID, model.ProposalVoteTypeApprove, bob)
+
+	// Assert
+	r.Equal(gqlerrors.ErrBranchRequiresApproval(masterBranch), directErr, "direct merge")
+	r.NoError(err, "vote")
+	r.Equal(model.ProposalStatusMerged, proposal.Status, "status")
+	r.Equal(tip(t, game, masterBranch).String(), *proposal.CommitID, "tip of master")
+	r.Equal(5, tipBoard(t, game, masterBranch)[0][0].Value, "merged")
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/engine"
+	"github.com/nhan-ng/sudoku/internal/puzzle"
+)
+
+// puzzleSection is the section of the repo config keeping the metadata of the game's puzzle
+const puzzleSection = "puzzle"
+
+func writePuzzleMetadata(repo *git.Repository, p *puzzle.Puzzle) error {
+	cfg, err := repo.Config()
+	if err != nil {
+		return fmt.Errorf("failed to read repo config: %w", err)
+	}
+
+	section := cfg.Raw.Section(puzzleSection)
+	section.SetOption("name", p.Name)
+	section.SetOption("source", string(p.Source))
+	section.SetOption("difficulty", string(p.Difficulty))
+
+	err = repo.Storer.SetConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to write repo config: %w", err)
+	}
+
+	return nil
+}
+
+func readPuzzleMetadata(repo *git.Repository, board engine.Board) (*model.PuzzleMetadata, error) {
+	cfg, err := repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repo config: %w", err)
+	}
+
+	p := &puzzle.Puzzle{
+		Source:     puzzle.SourceString,
+		Difficulty: engine.Rate(board),
+		Board:      board,
+	}
+	if cfg.Raw.HasSection(puzzleSection) {
+		section := cfg.Raw.Section(puzzleSection)
+		p.Name = section.Option("name")
+		p.Source = puzzle.Source(section.Option("source"))
+		p.Difficulty = engine.Difficulty(section.Option("difficulty"))
+	}
+
+	return ConvertPuzzleMetadata(p), nil
+}
+
+// choosePuzzle picks the puzzle of a new game from the input, generating one if none is given.
+func (r *Resolver) choosePuzzle(input model.CreateGameInput) (*puzzle.Puzzle, error) {
+	switch {
+	case input.Puzzle != nil:
+		p, err := puzzle.FromString(*input.Puzzle)
+		if err != nil {
+			return nil, gqlerrors.ErrInvalidPuzzle(err)
+		}
+		return p, nil
+
+	case input.PuzzleName != nil:
+		if r.library == nil {
+			return nil, gqlerrors.ErrPuzzleNotFound(*input.PuzzleName)
+		}
+		p, err := r.library.Get(*input.PuzzleName)
+		if errors.Is(err, puzzle.ErrPuzzleNotFound) || errors.Is(err, puzzle.ErrInvalidPuzzle) {
+			return nil, gqlerrors.ErrPuzzleNotFound(*input.PuzzleName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read puzzle %s: %w", *input.PuzzleName, err)
+		}
+		return p, nil
+
+	default:
+		difficulty := engine.DifficultyMedium
+		if input.Difficulty != nil {
+			difficulty = engine.Difficulty(*input.Difficulty)
+		}
+		return puzzle.Generate(difficulty)
+	}
+}
+
+func ConvertPuzzleMetadata(p *puzzle.Puzzle) *model.PuzzleMetadata {
+	return &model.PuzzleMetadata{
+		Name:       p.Name,
+		Source:     model.PuzzleSource(p.Source),
+		Difficulty: model.Difficulty(p.Difficulty),
+		Givens:     p.Givens(),
+	}
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/merge"
+)
+
+// Rebase replays the commits of the branch since its merge base with the other branch on top of the tip of the
+// other branch, one by one or squashed into a single commit. Merge commits are left out to keep the history linear,
+// the commits they merged are replayed instead. Nothing is written if a replayed commit changes a cell the other
+// branch changed since the merge base, every such cell is returned as a conflict.
+func (g *Game) Rebase(branchID, ontoBranchID string, squash bool, player *model.Player) (*model.RebaseBranchPayload, error) {
+	refs, err := g.findMergeBases(branchID, ontoBranchID)
+	if err != nil {
+		return nil, err
+	}
+	payload := &model.RebaseBranchPayload{
+		Branch:    g.ConvertBranch(refs.source),
+		Commits:   make([]*model.Commit, 0),
+		Conflicts: make([]*model.RebaseConflict, 0),
+	}
+
+	// The branch already contains the other branch
+	if len(refs.bases) == 1 && refs.bases[0].Hash == refs.target.Hash() {
+		return payload, nil
+	}
+
+	base, err := g.virtualBase(refs.bases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge base: %w", err)
+	}
+	branch, err := g.repo.CommitObject(refs.source.Hash())
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(refs.source.Hash().String())
+	}
+	onto, err := g.repo.CommitObject(refs.target.Hash())
+	if err != nil {
+		return nil, gqlerrors.ErrCommitNotFound(refs.target.Hash().String())
+	}
+	ontoBoard, err := ReadBoard(onto)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board: %w", err)
+	}
+
+	own, err := commitsExcept(branch, base.commits)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commits of branch %s: %w", branchID, err)
+	}
+	commits := make([]*object.Commit, 0, len(own))
+	for _, commit := range own {
+		if commit.NumParents() == 1 {
+			commits = append(commits, commit)
+		}
+	}
+	sortByAuthorTime(commits)
+
+	// Look for the cells changed on both branches before writing anything
+	for _, commit := range commits {
+		_, cells, err := diffCommit(commit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the changes of commit %s: %w", commit.Hash.String(), err)
+		}
+		for _, cell := range cells {
+			row, col := cell[0], cell[1]
+			if merge.CellEqual(base.board[row][col], ontoBoard[row][col]) {
+				continue
+			}
+
+			payload.Conflicts = append(payload.Conflicts, &model.RebaseConflict{
+				CommitID: commit.Hash.String(),
+				Row:      row,
+				Col:      col,
+				Base:     convertCellPtr(base.board[row][col]),
+				Onto:     convertCellPtr(ontoBoard[row][col]),
+			})
+		}
+	}
+	if len(payload.Conflicts) > 0 {
+		return payload, nil
+	}
+
+	// Replay the commits, keeping their authors
+	board := ontoBoard
+	tip := onto.Hash
+	written := make([]plumbing.Hash, 0, len(commits))
+	for _, commit := range commits {
+		board, err = ApplyCommit(board, commit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply commit %s: %w", commit.Hash.String(), err)
+		}
+		if squash {
+			continue
+		}
+
+		tip, err = writeCommit(g.repo.Storer, board, commit.Message, &commit.Author, tip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay commit %s: %w", commit.Hash.String(), err)
+		}
+		written = append(written, tip)
+	}
+	if squash && len(commits) > 0 {
+		tip, err = writeCommit(g.repo.Storer, board, fmt.Sprintf("%s %s %s", model.CommitTypeSquash, branchID, ontoBranchID), newSignature(player), tip)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write the squashed commit: %w", err)
+		}
+		written = append(written, tip)
+	}
+
+	newRef := plumbing.NewHashReference(refs.source.Name(), tip)
+	err = g.repo.Storer.CheckAndSetReference(newRef, refs.source)
+	if errors.Is(err, storage.ErrReferenceHasChanged) {
+		return nil, gqlerrors.ErrBranchChanged(branchID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to move the rebased branch: %w", err)
+	}
+	g.NotifyBranchEvent(&model.BranchEvent{
+		Type:           model.BranchEventTypeRebased,
+		BranchID:       branchID,
+		SourceBranchID: StringPtr(ontoBranchID),
+		OldCommitID:    StringPtr(refs.source.Hash().String()),
+		NewCommitID:    StringPtr(tip.String()),
+		Player:         player,
+	})
+
+	payload.Branch = g.ConvertBranch(newRef)
+	for _, hash := range written {
+		c, err := g.repo.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the commit %s: %w", hash.String(), err)
+		}
+		commit, err := g.ConvertCommit(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert commit: %w", err)
+		}
+		payload.Commits = append(payload.Commits, commit)
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+func TestGame_Rebase(t *testing.T) {
+	tests := []struct {
+		name      string
+		squash    bool
+		conflict  bool
+		upToDate  bool
+		commits   []model.CommitType
+		conflicts int
+	}{
+		{
+			name:    "Replay",
+			commits: []model.CommitType{model.CommitTypeAddFill, model.CommitTypeAddFill},
+		},
+		{
+			name:    "Squash",
+			squash:  true,
+			commits: []model.CommitType{model.CommitTypeSquash},
+		},
+		{
+			name:      "Conflict",
+			conflict:  true,
+			conflicts: 1,
+		},
+		{
+			name:     "UpToDate",
+			upToDate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			addBranch(t, game, "alice", masterBranch)
+			if !tt.upToDate {
+				commit(t, game, masterBranch, bob, fill(0, 4, 4))
+			}
+			if tt.conflict {
+				commit(t, game, masterBranch, bob, fill(0, 0, 8))
+			}
+			commit(t, game, "alice", alice, fill(0, 0, 5), fill(0, 2, 6))
+			before, onto := tip(t, game, "alice"), tip(t, game, masterBranch)
+
+			// Act
+			payload, err := game.Rebase("alice", masterBranch, tt.squash, alice)
+
+			// Assert
+			r.NoError(err, "rebase")
+			r.Len(payload.Conflicts, tt.conflicts, "conflicts")
+			types := make([]model.CommitType, 0)
+			for _, c := range payload.Commits {
+				types = append(types, c.Type)
+			}
+			r.Equal(append([]model.CommitType{}, tt.commits...), types, "commits")
+			if len(tt.commits) == 0 {
+				r.Equal(before, tip(t, game, "alice"), "tip untouched")
+				return
+			}
+
+			// The commits are on top of the other branch, one after the other
+			parent := onto.String()
+			for _, c := range payload.Commits {
+				r.Equal([]string{parent}, c.ParentIDs, "parents")
+				parent = c.ID
+			}
+			r.Equal(parent, tip(t, game, "alice").String(), "tip")
+			board := tipBoard(t, game, "alice")
+			r.Equal(5, board[0][0].Value, "rebased cell")
+			r.Equal(6, board[0][2].Value, "rebased cell")
+			r.Equal(4, board[0][4].Value, "onto cell")
+		})
+	}
+}
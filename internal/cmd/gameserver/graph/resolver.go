@@ -2,311 +2,195 @@
 package graph
 
 import (
-	"context"
 	"fmt"
-	"io"
-	"os"
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/generated"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
-
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/middleware"
 
-	"github.com/go-git/go-billy/v5/memfs"
-	"github.com/go-git/go-git/v5/storage/memory"
-
 	"github.com/go-git/go-git/v5/plumbing"
 
 	"github.com/go-git/go-git/v5/plumbing/object"
 
-	"github.com/go-git/go-billy/v5"
+	"github.com/google/uuid"
 
 	"go.uber.org/zap"
 
-	git "github.com/go-git/go-git/v5"
-
 	"github.com/nhan-ng/sudoku/internal/engine"
+	"github.com/nhan-ng/sudoku/internal/puzzle"
 )
 
-const sampleSudoku = `070308100
-040100000
-000090082
-001000500
-000000230
-000283070
-094005000
-526000700
-000000009`
-
 const gameFile = "game.dat"
 
+const masterBranch = "master"
+
+// DefaultGameID identifies the game started with the server, used when a request doesn't name a game
+const DefaultGameID = "default"
+
+// DefaultMaxGames is the number of games a server hosts if not set in the options
+const DefaultMaxGames = 100
+
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
-type ObserverCleanUpFunc func()
-
 type Resolver struct {
-	sudoku *model.Sudoku
-
-	players     map[string]*model.Player
-	playerNames map[string]struct{}
+	storage Storage
+	library *puzzle.Library
+	signer  *middleware.Signer
+	events  eventbus.Options
 
-	branchObservers map[string]*BranchObserver
-
-	repo *git.Repository
-	fs   billy.Filesystem
+	games    map[string]*Game
+	maxGames int
 
 	mu sync.RWMutex
 
 	*zap.Logger
 }
 
-type BranchObserver struct {
-	BranchID  string
-	Observers map[string]chan *model.Commit
-}
+type ResolverOptions struct {
+	// Storage backs the game repositories, in memory if not set
+	Storage Storage
 
-func NewResolver() (*generated.Config, error) {
-	resolver, err := newGame()
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize a new game: %w", err)
-	}
-	return &generated.Config{
-		Resolvers: resolver,
-	}, nil
-}
+	// Puzzle starts the default game if the storage has none yet
+	Puzzle *puzzle.Puzzle
 
-func newGame() (*Resolver, error) {
-	// Read the board
-	board, err := engine.ReadBoard(sampleSudoku)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create a Sudoku: %w", err)
-	}
+	// Library is where new games can pick a puzzle by name from, if set
+	Library *puzzle.Library
 
-	// Initialize the backing fs
-	zap.L().Info("Initialized origin repo in memory.")
-	fs := memfs.New()
-	storer := memory.NewStorage()
+	// Signer issues the session tokens of the players, with a random key if not set
+	Signer *middleware.Signer
 
-	// Initialize the repo
-	repo, err := git.Init(storer, fs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to initialize the repo: %w", err)
-	}
+	// Events configures the queues of the subscribers to the events of each game
+	Events eventbus.Options
 
-	w, err := repo.Worktree()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create worktree: %w", err)
-	}
+	// MaxGames caps the number of games, new games are refused past it. DefaultMaxGames if not set
+	MaxGames int
+}
 
-	// Add board to index
-	boardContent, err := board.Marshal()
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal board: %w", err)
+func NewResolver(opts ResolverOptions) (*generated.Config, error) {
+	storage := opts.Storage
+	if storage == nil {
+		storage = NewMemoryStorage()
 	}
-	err = WriteFile(fs, gameFile, boardContent, 0644)
-	if err != nil {
-		return nil, fmt.Errorf("failed to write data to file: %w", err)
-	}
-	_, err = w.Add(gameFile)
-	if err != nil {
-		return nil, fmt.Errorf("failed to add file to worktree: %w", err)
+
+	signer := opts.Signer
+	if signer == nil {
+		var err error
+		signer, err = middleware.NewRandomSigner()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Add board to index
-	sig := &object.Signature{
-		Name:  "Game Master",
-		Email: "gm@gitdoku.io",
-		When:  time.Now(),
+	maxGames := opts.MaxGames
+	if maxGames <= 0 {
+		maxGames = DefaultMaxGames
 	}
-	commitID, err := w.Commit(fmt.Sprintf("%s", model.CommitTypeInitial), &git.CommitOptions{
-		Author:    sig,
-		Committer: sig,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create initial commit: %w", err)
+
+	resolver := &Resolver{
+		storage:  storage,
+		library:  opts.Library,
+		signer:   signer,
+		events:   opts.Events,
+		games:    make(map[string]*Game),
+		maxGames: maxGames,
+		Logger:   zap.L(),
 	}
 
-	commits, err := repo.CommitObjects()
+	// Reopen every game kept in the storage, along with the default one. A game that won't open, like a stray
+	// folder of the storage, doesn't stop the others from being served
+	gameIDs, err := storage.List()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create initial commit: %w", err)
+		return nil, fmt.Errorf("failed to list the games: %w", err)
 	}
-
-	err = commits.ForEach(func(commit *object.Commit) error {
-		file, err := commit.File(gameFile)
+	for _, gameID := range gameIDs {
+		game, err := openGame(storage, gameID, nil, opts.Events)
 		if err != nil {
-			return err
+			resolver.Warn("Failed to reopen game, skipping it.", zap.String("gameId", gameID), zap.Error(err))
+			continue
 		}
-		content, err := file.Contents()
+		resolver.games[gameID] = game
+	}
+	if _, ok := resolver.games[DefaultGameID]; !ok {
+		game, err := openGame(storage, DefaultGameID, opts.Puzzle, opts.Events)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("failed to initialize a new game: %w", err)
 		}
-
-		zap.L().Info("Commit: ", zap.String("commitId", commit.Hash.String()), zap.String("content", content))
-		return nil
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create initial commit: %w", err)
+		resolver.games[DefaultGameID] = game
 	}
 
-	zap.L().Info("Initialized origin repo.", zap.String("commitId", commitID.String()))
-
-	// Prepare the sudoku
-	sudoku := &model.Sudoku{
-		BranchID: "master",
-		Board:    board.GetImmutableBoards(),
-	}
-
-	// Prepare the observers
-	branchObservers := make(map[string]*BranchObserver)
-
-	return &Resolver{
-		sudoku:          sudoku,
-		players:         make(map[string]*model.Player),
-		playerNames:     make(map[string]struct{}),
-		repo:            repo,
-		fs:              fs,
-		branchObservers: branchObservers,
-		Logger:          zap.L(),
+	return &generated.Config{
+		Resolvers: resolver,
 	}, nil
 }
 
-func (r *Resolver) AddBranchObserver(branchID, observerID string) (<-chan *model.Commit, ObserverCleanUpFunc, error) {
-	branch, ok := r.branchObservers[branchID]
-	if !ok {
-		branch = &BranchObserver{
-			BranchID:  branchID,
-			Observers: make(map[string]chan *model.Commit),
-		}
-		r.branchObservers[branchID] = branch
-	}
-
-	// Add the observer in
-	observers := branch.Observers
-	commitsChan := make(chan *model.Commit, 3)
-	observers[observerID] = commitsChan
-	cleanUp := func() {
-		delete(observers, observerID)
-	}
-
-	return commitsChan, cleanUp, nil
-}
-
-func (r *Resolver) NotifyObservers(branchID string, commit *model.Commit) {
-	branch, ok := r.branchObservers[branchID]
-	if !ok {
-		return
-	}
+// addGame starts a new game from the puzzle, unless the server hosts as many games as it can already.
+func (r *Resolver) addGame(p *puzzle.Puzzle) (*Game, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
-	for _, observer := range branch.Observers {
-		observer <- commit
+	if len(r.games) >= r.maxGames {
+		return nil, gqlerrors.ErrTooManyGames(r.maxGames)
 	}
-}
 
-func (r *Resolver) Close() error {
-	type fsBased interface {
-		Filesystem() billy.Filesystem
-	}
-
-	fs, isFSBased := r.fs.(fsBased)
-	if !isFSBased {
-		return nil
+	gameID := uuid.NewString()
+	game, err := openGame(r.storage, gameID, p, r.events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game: %w", err)
 	}
+	r.games[gameID] = game
 
-	root := fs.Filesystem().Root()
-	r.Info("Removing root folder.", zap.String("root", root))
-
-	// Clean up filesystem
-	return os.RemoveAll(root)
+	return game, nil
 }
 
-func (r *Resolver) ReadBoard() (engine.Board, error) {
-	// Get the head commit
-	ref, err := r.repo.Head()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get HEAD: %w", err)
-	}
-
-	commit, err := r.repo.CommitObject(ref.Hash())
-	if err != nil {
-		return nil, fmt.Errorf("failed to read HEAD commit %s: %w", ref.Hash().String(), err)
-	}
+// canAddGame checks the server can host another game, before preparing it.
+func (r *Resolver) canAddGame() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	// Read file
-	board, err := ReadBoard(commit)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read board: %w", err)
+	if len(r.games) >= r.maxGames {
+		return gqlerrors.ErrTooManyGames(r.maxGames)
 	}
 
-	return board, nil
+	return nil
 }
 
-func (r *Resolver) CommitBoard(worktree *git.Worktree, board engine.Board, message string, player *model.Player) (*object.Commit, error) {
-	// Add board to index
-	boardContent, err := board.Marshal()
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal board: %w", err)
-	}
-
-	// Add the change
-	r.writeGameFile(boardContent)
-	_, err = worktree.Add(gameFile)
-	if err != nil {
-		return nil, fmt.Errorf("failed to add file to worktree: %w", err)
-	}
-
-	// Add board to index
-	sig := &object.Signature{
-		Name:  player.DisplayName,
-		Email: player.ID,
-		When:  time.Now(),
-	}
-	commitID, err := worktree.Commit(message, &git.CommitOptions{
-		Author:    sig,
-		Committer: sig,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to commit: %w", err)
-	}
+func (r *Resolver) getGame(gameID string) (*Game, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
-	commit, err := r.repo.CommitObject(commitID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load the commit %s: %w", commitID.String(), err)
+	game, ok := r.games[gameID]
+	if !ok {
+		return nil, gqlerrors.ErrGameNotFound(gameID)
 	}
 
-	return commit, nil
+	return game, nil
 }
 
-func (r *Resolver) writeGameFile(data []byte) error {
-	err := WriteFile(r.fs, gameFile, data, 0644)
-	if err != nil {
-		return fmt.Errorf("failed to write data to file: %w", err)
-	}
-	return nil
-}
-
-func (r *Resolver) getPlayer(ctx context.Context) (*model.Player, error) {
-	ip, err := middleware.ForContext(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get the IP from the context: %w", err)
-	}
-
-	player, ok := r.players[ip]
-	if !ok {
-		return nil, fmt.Errorf("player did not exist for IP: %s", ip)
+func (r *Resolver) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result error
+	for _, game := range r.games {
+		err := game.Close()
+		if err != nil && result == nil {
+			result = fmt.Errorf("failed to close game %s: %w", game.ID, err)
+		}
 	}
 
-	return player, nil
+	return result
 }
 
 func ApplyCommit(board engine.Board, commit *object.Commit) (engine.Board, error) {
 	// Parse the commit message
-	parts := strings.Split(commit.Message, " ")
+	parts := strings.Split(commitSummary(commit.Message), " ")
 	commitType := parts[0]
 	switch model.CommitType(commitType) {
 	case model.CommitTypeAddFill:
@@ -333,6 +217,20 @@ func ApplyCommit(board engine.Board, commit *object.Commit) (engine.Board, error
 		row, col, val := numbers[0], numbers[1], numbers[2]
 		board[row][col].Notes[val-1] = !board[row][col].Notes[val-1]
 
+	case model.CommitTypeSquash, model.CommitTypeMerge:
+		// A squashed or merge commit has more than 1 change, copy the cells it changed from its own board. A merge
+		// commit settles the cells its parents disagree on, even when kept as on its first parent, like a conflict
+		// resolved by hand
+		after, cells, err := diffParents(commit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the changes of the %s commit: %w", strings.ToLower(commitType), err)
+		}
+		for _, cell := range cells {
+			row, col := cell[0], cell[1]
+			board[row][col].Value = after[row][col].Value
+			board[row][col].Notes = after[row][col].Notes
+		}
+
 	case model.CommitTypeUnknown:
 		return nil, fmt.Errorf("unreachable commit type %s", commitType)
 	}
@@ -360,22 +258,6 @@ func ReadBoard(commit *object.Commit) (engine.Board, error) {
 	return board, nil
 }
 
-func WriteFile(fs billy.Filesystem, filename string, data []byte, perm os.FileMode) error {
-	f, err := fs.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
-	if err != nil {
-		return err
-	}
-
-	n, err := f.Write(data)
-	if err == nil && n < len(data) {
-		err = io.ErrShortWrite
-	}
-	if err1 := f.Close(); err == nil {
-		err = err1
-	}
-	return err
-}
-
 func MarshalBoard(data []byte) (engine.Board, error) {
 	var board engine.Board
 	err := board.Unmarshal(data)
@@ -386,17 +268,19 @@ func MarshalBoard(data []byte) (engine.Board, error) {
 	return board, nil
 }
 
+// commitSummary returns the first line of the commit message, the change of the commit. The lines after it are
+// trailers, like the commit undone by the commit.
+func commitSummary(message string) string {
+	return strings.SplitN(message, "\n", 2)[0]
+}
+
 func ConvertBlob(board engine.Board) *model.Blob {
 	// Convert from blob to board
 	b := make([][]model.Cell, 9)
 	for i, row := range board {
 		r := make([]model.Cell, 9)
 		for j, cell := range row {
-			r[j] = model.Cell{
-				Immutable: cell.Immutable,
-				Val:       cell.Value,
-				Notes:     cell.Notes.AsNumbers(),
-			}
+			r[j] = ConvertCell(cell)
 		}
 		b[i] = r
 	}
@@ -404,6 +288,14 @@ func ConvertBlob(board engine.Board) *model.Blob {
 	return &model.Blob{Board: b}
 }
 
+func ConvertCell(cell engine.Cell) model.Cell {
+	return model.Cell{
+		Immutable: cell.Immutable,
+		Val:       cell.Value,
+		Notes:     cell.Notes.AsNumbers(),
+	}
+}
+
 func ConvertBranch(ref *plumbing.Reference) *model.Branch {
 	if ref == nil {
 		return nil
@@ -433,7 +325,7 @@ func ConvertCommit(commit *object.Commit) (*model.Commit, error) {
 	}
 
 	// Parse message
-	parts := strings.Split(commit.Message, " ")
+	parts := strings.Split(commitSummary(commit.Message), " ")
 	result.Type = model.CommitType(parts[0])
 	switch result.Type {
 	case model.CommitTypeAddFill, model.CommitTypeToggleNote:
@@ -478,3 +370,11 @@ func IntPtr(i int) *int {
 func StringPtr(s string) *string {
 	return &s
 }
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/middleware"
+)
+
+var testSigner = middleware.NewSigner([]byte("key"))
+
+// newTestResolver starts the default game in memory, closed at the end of the test.
+func newTestResolver(t *testing.T) (*Resolver, *Game) {
+	cfg, err := NewResolver(ResolverOptions{Puzzle: newTestPuzzle(t), Signer: testSigner})
+	require.NoError(t, err, "resolver")
+	res := cfg.Resolvers.(*Resolver)
+	t.Cleanup(func() {
+		_ = res.Close()
+	})
+	game, err := res.getGame(DefaultGameID)
+	require.NoError(t, err, "game")
+
+	return res, game
+}
+
+// join joins the default game as a new player, and returns the context of its requests.
+func join(t *testing.T, res *Resolver) (context.Context, *model.Player) {
+	payload, err := (&mutationResolver{res}).Join(context.Background(), DefaultGameID)
+	require.NoError(t, err, "join")
+
+	// Resolve the session of the token like the requests of the player
+	var ctx context.Context
+	handler := middleware.SessionMiddleware(testSigner)(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx = req.Context()
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+payload.Token)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.NotNil(t, ctx, "session")
+
+	return ctx, payload.Player
+}
+
+func TestNewResolver_GamesThatWontOpen_SkipThem(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, a stray folder and a game without master
+	storage := FilesystemStorage{Root: t.TempDir()}
+	r.NoError(os.Mkdir(filepath.Join(storage.Root, "stray"), 0o755), "stray folder")
+	_, err := storage.Init("half-created")
+	r.NoError(err, "half-created game")
+	game, err := openGame(storage, "game", newTestPuzzle(t), eventbus.Options{})
+	r.NoError(err, "game")
+	r.NoError(game.Close(), "close")
+
+	// Act
+	cfg, err := NewResolver(ResolverOptions{Storage: storage, Puzzle: newTestPuzzle(t), Signer: testSigner})
+
+	// Assert
+	r.NoError(err, "resolver")
+	res := cfg.Resolvers.(*Resolver)
+	defer res.Close()
+	r.Len(res.games, 2, "games")
+	r.Contains(res.games, "game", "reopened game")
+	r.Contains(res.games, DefaultGameID, "default game")
+}
+
+func TestMutationResolver_CreateGame_TooManyGames_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, a server hosting the default game and room for a single other game
+	cfg, err := NewResolver(ResolverOptions{Puzzle: newTestPuzzle(t), Signer: testSigner, MaxGames: 2})
+	r.NoError(err, "resolver")
+	res := cfg.Resolvers.(*Resolver)
+	defer res.Close()
+	input := model.CreateGameInput{Puzzle: StringPtr(testPuzzle)}
+	_, err = (&mutationResolver{res}).CreateGame(context.Background(), input)
+	r.NoError(err, "first game")
+
+	// Act
+	payload, err := (&mutationResolver{res}).CreateGame(context.Background(), input)
+
+	// Assert
+	r.Equal(gqlerrors.ErrTooManyGames(2), err, "err")
+	r.Nil(payload, "payload")
+	r.Len(res.games, 2, "games")
+}
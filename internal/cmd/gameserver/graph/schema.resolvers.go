@@ -5,8 +5,10 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	git "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -16,20 +18,23 @@ import (
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/middleware"
+	"github.com/nhan-ng/sudoku/internal/engine"
 	"github.com/nhan-ng/sudoku/internal/namesgenerator"
 	"go.uber.org/zap"
 )
 
 func (r *branchResolver) Commit(ctx context.Context, obj *model.Branch) (*model.Commit, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
 
-	commit, err := r.repo.CommitObject(plumbing.NewHash(obj.CommitID))
+	commit, err := game.repo.CommitObject(plumbing.NewHash(obj.CommitID))
 	if err != nil {
 		return nil, gqlerrors.ErrCommitNotFound(obj.CommitID)
 	}
 
-	result, err := ConvertCommit(commit)
+	result, err := game.ConvertCommit(commit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert result: %w", err)
 	}
@@ -38,10 +43,12 @@ func (r *branchResolver) Commit(ctx context.Context, obj *model.Branch) (*model.
 }
 
 func (r *branchResolver) Commits(ctx context.Context, obj *model.Branch) ([]*model.Commit, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
 
-	commits, err := r.repo.Log(&git.LogOptions{
+	commits, err := game.repo.Log(&git.LogOptions{
 		From: plumbing.NewHash(obj.CommitID),
 	})
 	if err != nil {
@@ -50,7 +57,7 @@ func (r *branchResolver) Commits(ctx context.Context, obj *model.Branch) ([]*mod
 
 	result := make([]*model.Commit, 0)
 	err = commits.ForEach(func(c *object.Commit) error {
-		commit, err := ConvertCommit(c)
+		commit, err := game.ConvertCommit(c)
 		if err != nil {
 			return fmt.Errorf("failed to convert commit: %w", err)
 		}
@@ -64,17 +71,43 @@ func (r *branchResolver) Commits(ctx context.Context, obj *model.Branch) ([]*mod
 	return result, nil
 }
 
+func (r *branchResolver) OwnerID(ctx context.Context, obj *model.Branch) (*string, error) {
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, _ := game.BranchRules(obj.ID)
+	if owner == "" {
+		return nil, nil
+	}
+
+	return &owner, nil
+}
+
+func (r *branchResolver) Protection(ctx context.Context, obj *model.Branch) (*model.BranchProtection, error) {
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, protection := game.BranchRules(obj.ID)
+	return &protection, nil
+}
+
 func (r *commitResolver) Parents(ctx context.Context, obj *model.Commit) ([]*model.Commit, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
 
 	result := make([]*model.Commit, 0, len(obj.ParentIDs))
 	for _, parentID := range obj.ParentIDs {
-		parentCommit, err := r.repo.CommitObject(plumbing.NewHash(parentID))
+		parentCommit, err := game.repo.CommitObject(plumbing.NewHash(parentID))
 		if err != nil {
 			return nil, gqlerrors.ErrCommitNotFound(parentID)
 		}
-		c, err := ConvertCommit(parentCommit)
+		c, err := game.ConvertCommit(parentCommit)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert parent commit: %w", err)
 		}
@@ -85,10 +118,12 @@ func (r *commitResolver) Parents(ctx context.Context, obj *model.Commit) ([]*mod
 }
 
 func (r *commitResolver) Blob(ctx context.Context, obj *model.Commit) (*model.Blob, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
 
-	commit, err := r.repo.CommitObject(plumbing.NewHash(obj.ID))
+	commit, err := game.repo.CommitObject(plumbing.NewHash(obj.ID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to read commit: %w", err)
 	}
@@ -101,7 +136,52 @@ func (r *commitResolver) Blob(ctx context.Context, obj *model.Commit) (*model.Bl
 	return ConvertBlob(board), nil
 }
 
+func (r *gameResolver) Sudoku(ctx context.Context, obj *model.Game) (*model.Sudoku, error) {
+	game, err := r.getGame(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.sudoku, nil
+}
+
+func (r *gameResolver) Players(ctx context.Context, obj *model.Game) ([]*model.Player, error) {
+	return (&queryResolver{r.Resolver}).Players(ctx, obj.ID)
+}
+
+func (r *gameResolver) Branches(ctx context.Context, obj *model.Game) ([]*model.Branch, error) {
+	return (&queryResolver{r.Resolver}).Branches(ctx, obj.ID)
+}
+
+func (r *mutationResolver) CreateGame(ctx context.Context, input model.CreateGameInput) (*model.CreateGamePayload, error) {
+	// Refuse the game before generating its puzzle, the number of games is checked again when it is added
+	err := r.canAddGame()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := r.choosePuzzle(input)
+	if err != nil {
+		return nil, err
+	}
+
+	game, err := r.addGame(p)
+	if err != nil {
+		return nil, err
+	}
+	r.Info("Created game.", zap.String("gameId", game.ID), zap.String("puzzle", p.Name))
+
+	return &model.CreateGamePayload{
+		Game: &model.Game{ID: game.ID},
+	}, nil
+}
+
 func (r *mutationResolver) AddCommit(ctx context.Context, input model.AddCommitInput) (*model.AddCommitPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate
 	switch input.Type {
 	case model.CommitTypeAddFill, model.CommitTypeRemoveFill, model.CommitTypeToggleNote:
@@ -111,11 +191,11 @@ func (r *mutationResolver) AddCommit(ctx context.Context, input model.AddCommitI
 		if input.Col < 0 || input.Col >= 9 {
 			return nil, gqlerrors.ErrInvalidInputCoordinate()
 		}
-		if r.sudoku.HasConflictWithFixedBoard(input.Row, input.Col) {
+		if game.sudoku.HasConflictWithFixedBoard(input.Row, input.Col) {
 			return nil, gqlerrors.ErrInvalidInputCoordinate()
 		}
 
-	case model.CommitTypeUnknown, model.CommitTypeInitial, model.CommitTypeMerge:
+	case model.CommitTypeUnknown, model.CommitTypeInitial, model.CommitTypeMerge, model.CommitTypeSquash:
 		return nil, gqlerrors.ErrInvalidInputCommitType(input.Type)
 	}
 
@@ -127,71 +207,40 @@ func (r *mutationResolver) AddCommit(ctx context.Context, input model.AddCommitI
 		}
 	}
 
-	// Create a commit
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
 	// Verify the author
-	player, err := r.getPlayer(ctx)
+	player, err := game.getPlayer(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get player: %w", err)
 	}
 
-	// Verify the branch
-	ref, err := r.repo.Reference(plumbing.NewBranchReferenceName(input.BranchID), false)
-	if err != nil {
-		return nil, gqlerrors.ErrBranchNotFound(input.BranchID)
-	}
-
-	// Create a commit
-	wt, err := r.repo.Worktree()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get worktree: %w", err)
-	}
-
-	// Checkout
-	err = wt.Checkout(&git.CheckoutOptions{
-		Branch: ref.Name(),
-		Force:  true,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get worktree: %w", err)
-	}
-
-	// Make the change
-	board, err := r.ReadBoard()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read board from current worktree: %w", err)
-	}
-
-	cell := &board[input.Row][input.Col]
-	var commitMessage string
-	switch input.Type {
-	case model.CommitTypeAddFill:
-		cell.Value = *input.Val
-		commitMessage = fmt.Sprintf("%s %d %d %d", input.Type, input.Row, input.Col, *input.Val)
-
-	case model.CommitTypeToggleNote:
-		cell.Notes[*input.Val-1] = !cell.Notes[*input.Val-1]
-		commitMessage = fmt.Sprintf("%s %d %d %d", input.Type, input.Row, input.Col, *input.Val)
-
-	case model.CommitTypeRemoveFill:
-		cell.Value = 0
-		commitMessage = fmt.Sprintf("%s %d %d", input.Type, input.Row, input.Col)
-	}
-
-	// Commit the change
-	c, err := r.CommitBoard(wt, board, commitMessage, player)
+	// Make the change on the tip of the branch. Commits don't wait for the goroutine of the game, the branch itself is
+	// moved with compare-and-swap
+	c, err := game.CommitChange(input.BranchID, func(board engine.Board) (string, error) {
+		cell := &board[input.Row][input.Col]
+		switch input.Type {
+		case model.CommitTypeAddFill:
+			cell.Value = *input.Val
+			return fmt.Sprintf("%s %d %d %d", input.Type, input.Row, input.Col, *input.Val), nil
+
+		case model.CommitTypeToggleNote:
+			cell.Notes[*input.Val-1] = !cell.Notes[*input.Val-1]
+			return fmt.Sprintf("%s %d %d %d", input.Type, input.Row, input.Col, *input.Val), nil
+
+		default:
+			cell.Value = 0
+			return fmt.Sprintf("%s %d %d", input.Type, input.Row, input.Col), nil
+		}
+	}, player)
 	if err != nil {
-		return nil, fmt.Errorf("failed to commit the board change: %w", err)
+		return nil, err
 	}
-	commit, err := ConvertCommit(c)
+	commit, err := game.ConvertCommit(c)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert commit: %w", err)
 	}
 
 	// Broadcast the change
-	r.NotifyObservers(input.BranchID, commit)
+	game.NotifyObservers(input.BranchID, commit)
 
 	return &model.AddCommitPayload{
 		Commit: commit,
@@ -199,12 +248,20 @@ func (r *mutationResolver) AddCommit(ctx context.Context, input model.AddCommitI
 }
 
 func (r *mutationResolver) AddBranch(ctx context.Context, input model.AddBranchInput) (*model.AddBranchPayload, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The player creating the branch owns it
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := r.repo.Reference(plumbing.NewBranchReferenceName(input.ID), false)
-	if err == nil {
-		return nil, gqlerrors.ErrBranchAlreadyExists(input.ID)
+	err = validateBranchName(input.ID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate that both arguments can't be specified
@@ -217,7 +274,7 @@ func (r *mutationResolver) AddBranch(ctx context.Context, input model.AddBranchI
 	if input.CommitID != nil {
 		commitID = plumbing.NewHash(*input.CommitID)
 	} else {
-		ref, err := r.repo.Reference(plumbing.NewBranchReferenceName(*input.BranchID), false)
+		ref, err := game.repo.Reference(plumbing.NewBranchReferenceName(*input.BranchID), false)
 		if err != nil {
 			return nil, gqlerrors.ErrBranchNotFound(*input.BranchID)
 		}
@@ -225,222 +282,633 @@ func (r *mutationResolver) AddBranch(ctx context.Context, input model.AddBranchI
 	}
 
 	// Check if the commit exists
-	_, err = r.repo.CommitObject(commitID)
+	_, err = game.repo.CommitObject(commitID)
 	if err != nil {
 		return nil, gqlerrors.ErrCommitNotFound(commitID.String())
 	}
 
+	// Branches are only created and removed by the goroutine of the game, so the branch can't appear in between
 	newRef := plumbing.NewHashReference(plumbing.NewBranchReferenceName(input.ID), commitID)
-	err = r.repo.Storer.SetReference(newRef)
+	err = game.do(func() error {
+		_, err := game.repo.Reference(newRef.Name(), false)
+		if err == nil {
+			return gqlerrors.ErrBranchAlreadyExists(input.ID)
+		}
+
+		err = game.repo.Storer.SetReference(newRef)
+		if err != nil {
+			return fmt.Errorf("failed to create a new branch: %w", err)
+		}
+		game.branches[input.ID] = &branchRules{owner: player.ID}
+		return game.saveBranchRules()
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create a new branch: %w", err)
+		return nil, err
 	}
 
+	game.NotifyBranchEvent(&model.BranchEvent{
+		Type:        model.BranchEventTypeCreated,
+		BranchID:    input.ID,
+		NewCommitID: StringPtr(commitID.String()),
+		Player:      player,
+	})
+
 	return &model.AddBranchPayload{
-		Branch: ConvertBranch(newRef),
+		Branch: game.ConvertBranch(newRef),
 	}, nil
 }
 
+func (r *mutationResolver) DeleteBranch(ctx context.Context, input model.DeleteBranchInput) (*model.DeleteBranchPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := game.DeleteBranch(input.BranchID, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.DeleteBranchPayload{Branch: game.ConvertBranch(ref)}, nil
+}
+
+func (r *mutationResolver) RenameBranch(ctx context.Context, input model.RenameBranchInput) (*model.RenameBranchPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := game.RenameBranch(input.BranchID, input.NewBranchID, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.RenameBranchPayload{Branch: game.ConvertBranch(ref)}, nil
+}
+
+func (r *mutationResolver) ResetBranch(ctx context.Context, input model.ResetBranchInput) (*model.ResetBranchPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := game.ResetBranch(input.BranchID, input.CommitID, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ResetBranchPayload{Branch: game.ConvertBranch(ref)}, nil
+}
+
+func (r *mutationResolver) SetBranchProtection(ctx context.Context, input model.SetBranchProtectionInput) (*model.SetBranchProtectionPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := game.SetBranchProtection(input, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SetBranchProtectionPayload{Branch: game.ConvertBranch(ref)}, nil
+}
+
 func (r *mutationResolver) MergeBranch(ctx context.Context, input model.MergeBranchInput) (*model.MergeBranchPayload, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get player
-	player, err := r.getPlayer(ctx)
+	player, err := game.getPlayer(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get player: %w", err)
 	}
 
-	// Get source
-	sourceRef, err := r.repo.Reference(plumbing.NewBranchReferenceName(input.SourceBranchID), false)
+	return game.MergeBranch(input.SourceBranchID, input.TargetBranchID, mergeStrategy(input.Strategy), player, "")
+}
+
+func (r *mutationResolver) ResolveMerge(ctx context.Context, input model.ResolveMergeInput) (*model.ResolveMergePayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The merge is done with once committed, a concurrent resolution of the same merge fails as the source branch moved
+	var pending *pendingMerge
+	var board engine.Board
+	err = game.do(func() error {
+		var ok bool
+		pending, ok = game.merges[input.MergeID]
+		if !ok {
+			return gqlerrors.ErrMergeNotFound(input.MergeID)
+		}
+		action := actionMerge
+		if pending.proposalID != "" {
+			action = actionApprovedMerge
+		}
+		err = game.authorize(pending.SourceBranchID, player, action)
+		if err != nil {
+			return err
+		}
+
+		board, err = pending.Resolve(input.Resolutions)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the merge if the merge commit fails, for the players to resolve it again
+	payload, err := game.commitMerge(pending, board, player)
+	if err != nil {
+		return nil, err
+	}
+	err = game.do(func() error {
+		delete(game.merges, input.MergeID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pending.proposalID != "" {
+		_, err = game.completeProposal(pending.proposalID, payload.SourceBranch.CommitID, player)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &model.ResolveMergePayload{
+		SourceBranch: payload.SourceBranch,
+		Commit:       payload.Commit,
+	}, nil
+}
+
+func (r *mutationResolver) CherryPick(ctx context.Context, input model.CherryPickInput) (*model.CherryPickPayload, error) {
+	game, err := r.getGame(input.GameID)
 	if err != nil {
-		return nil, gqlerrors.ErrBranchNotFound(input.SourceBranchID)
+		return nil, err
 	}
-	sourceCommit, err := r.repo.CommitObject(sourceRef.Hash())
+
+	player, err := game.getPlayer(ctx)
 	if err != nil {
-		return nil, gqlerrors.ErrCommitNotFound(sourceRef.Hash().String())
+		return nil, err
 	}
 
-	// Get target
-	targetRef, err := r.repo.Reference(plumbing.NewBranchReferenceName(input.TargetBranchID), false)
+	c, err := game.CherryPick(input.CommitID, input.BranchID, player)
+	var conflict *cellConflictError
+	if errors.As(err, &conflict) {
+		return &model.CherryPickPayload{Conflict: conflict.conflict}, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get target branch: %w", err)
+		return nil, err
 	}
-	targetCommit, err := r.repo.CommitObject(targetRef.Hash())
+	commit, err := game.ConvertCommit(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert commit: %w", err)
+	}
+	game.NotifyObservers(input.BranchID, commit)
+
+	return &model.CherryPickPayload{Commit: commit}, nil
+}
+
+func (r *mutationResolver) RevertCommit(ctx context.Context, input model.RevertCommitInput) (*model.RevertCommitPayload, error) {
+	game, err := r.getGame(input.GameID)
 	if err != nil {
-		return nil, gqlerrors.ErrCommitNotFound(targetRef.Hash().String())
+		return nil, err
 	}
 
-	bases, err := sourceCommit.MergeBase(targetCommit)
+	player, err := game.getPlayer(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find merge base: %w", err)
+		return nil, err
 	}
 
-	// Cris-cross scenario
-	// Recursive merge strategy would create a virtual branch, but that's a bit complicated
-	//	---1---o---A
-	//	    \ /
-	//	     X
-	//	    / \
-	//	---2---o---o---B
-	if len(bases) != 1 {
-		return nil, fmt.Errorf("failed to merge branches with more than 1 ancestors")
+	c, err := game.Revert(input.CommitID, input.BranchID, player)
+	var conflict *cellConflictError
+	if errors.As(err, &conflict) {
+		return &model.RevertCommitPayload{Conflict: conflict.conflict}, nil
 	}
+	if err != nil {
+		return nil, err
+	}
+	commit, err := game.ConvertCommit(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert commit: %w", err)
+	}
+	game.NotifyObservers(input.BranchID, commit)
 
-	// If base == source then we can fast-forward because source is ancestor of target
-	if bases[0].Hash == sourceRef.Hash() {
-		newRef := plumbing.NewHashReference(sourceRef.Name(), targetCommit.Hash)
-		r.repo.Storer.SetReference(newRef)
+	return &model.RevertCommitPayload{Commit: commit}, nil
+}
 
-		return &model.MergeBranchPayload{
-			SourceBranch: ConvertBranch(newRef),
-		}, nil
+func (r *mutationResolver) RebaseBranch(ctx context.Context, input model.RebaseBranchInput) (*model.RebaseBranchPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
 	}
 
-	baseCommitReached := fmt.Errorf("base commit reached")
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// Otherwise, use operation-base merge instead of git 3-way recursive state-base merge
-	// Coalesce the commits of the 2 branches, chronologically sorted and perform the commits on top the base ancestor
-	commits := make(map[plumbing.Hash]*object.Commit)
-	base := bases[0]
-	sourceCommitsIter, err := r.repo.Log(&git.LogOptions{
-		From:  sourceCommit.Hash,
-		Order: git.LogOrderCommitterTime,
-	})
+	// Rebasing rewrites the commits of the branch
+	err = game.authorize(input.BranchID, player, actionForceReset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get the commits from source branch")
+		return nil, err
+	}
+
+	return game.Rebase(input.BranchID, input.OntoBranchID, input.Squash != nil && *input.Squash, player)
+}
+
+func (r *mutationResolver) OpenProposal(ctx context.Context, input model.OpenProposalInput) (*model.OpenProposalPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := game.OpenProposal(input, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.OpenProposalPayload{Proposal: proposal}, nil
+}
+
+func (r *mutationResolver) CommentProposal(ctx context.Context, input model.CommentProposalInput) (*model.CommentProposalPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, comment, err := game.CommentProposal(input.ProposalID, input.Text, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CommentProposalPayload{
+		Proposal: proposal,
+		Comment:  comment,
+	}, nil
+}
+
+func (r *mutationResolver) VoteProposal(ctx context.Context, input model.VoteProposalInput) (*model.VoteProposalPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
 	}
-	err = sourceCommitsIter.ForEach(func(c *object.Commit) error {
-		// We are done here
-		if base.Hash == c.Hash {
-			return baseCommitReached
-		}
 
-		commits[c.Hash] = c
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, err := game.VoteProposal(input.ProposalID, input.Type, player)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.VoteProposalPayload{Proposal: proposal}, nil
+}
+
+func (r *mutationResolver) Undo(ctx context.Context, gameID string, branchID string) (*model.UndoPayload, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := game.Undo(branchID, player)
+	var conflict *cellConflictError
+	if errors.As(err, &conflict) {
+		return &model.UndoPayload{Conflict: conflict.conflict}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	commit, err := game.ConvertCommit(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert commit: %w", err)
+	}
+	game.NotifyObservers(branchID, commit)
+
+	return &model.UndoPayload{Commit: commit}, nil
+}
+
+func (r *mutationResolver) Redo(ctx context.Context, gameID string, branchID string) (*model.RedoPayload, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := game.Redo(branchID, player)
+	var conflict *cellConflictError
+	if errors.As(err, &conflict) {
+		return &model.RedoPayload{Conflict: conflict.conflict}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	commit, err := game.ConvertCommit(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert commit: %w", err)
+	}
+	game.NotifyObservers(branchID, commit)
+
+	return &model.RedoPayload{Commit: commit}, nil
+}
+
+func (r *mutationResolver) Join(ctx context.Context, gameID string) (*model.JoinPayload, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the player if the session already joined the game
+	var player *model.Player
+	joined := false
+	err = game.do(func() error {
+		player, err = game.getPlayer(ctx)
+		if err == nil {
+			return nil
+		}
+		joined = true
+
+		// Otherwise add a new player
+		newPlayerName := namesgenerator.GetUniqueRandomName(game.playerNames)
+		player = &model.Player{
+			ID:          uuid.NewString(),
+			DisplayName: newPlayerName,
+			Color:       game.nextColor(),
+		}
+		game.playerNames[newPlayerName] = struct{}{}
+		game.players[player.ID] = player
+		game.NotifyPlayersChanged(model.PlayerEventTypeJoined, player)
 		return nil
 	})
-	if err != nil && err != baseCommitReached {
-		return nil, fmt.Errorf("failed to iterate through commits from source branch: %w", err)
+	if err != nil {
+		return nil, err
+	}
+	if joined {
+		// The address, behind the trusted proxies, is only logged to trace abuse, players are identified by their token
+		ip, _ := middleware.ForContext(ctx)
+		game.Info("Player joined.", zap.String("playerId", player.ID), zap.String("ip", ip))
 	}
 
-	targetCommitsIter, err := r.repo.Log(&git.LogOptions{
-		From:  targetCommit.Hash,
-		Order: git.LogOrderCommitterTime,
+	token, err := r.signer.Sign(middleware.Session{
+		GameID:   game.ID,
+		PlayerID: player.ID,
+		IssuedAt: time.Now(),
 	})
-	err = targetCommitsIter.ForEach(func(c *object.Commit) error {
-		if base.Hash == c.Hash {
-			return baseCommitReached
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue the session token: %w", err)
+	}
+
+	return &model.JoinPayload{Player: player, Token: token}, nil
+}
+
+func (r *mutationResolver) UpdatePlayer(ctx context.Context, input model.UpdatePlayerInput) (*model.UpdatePlayerPayload, error) {
+	game, err := r.getGame(input.GameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated model.Player
+	err = game.do(func() error {
+		player, err := game.getPlayer(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Players are shared with the snapshots, replace the player instead of changing it
+		updated = *player
+		if input.DisplayName != nil {
+			updated.DisplayName, err = game.validateDisplayName(player.ID, *input.DisplayName)
+			if err != nil {
+				return err
+			}
+		}
+		if input.Color != nil {
+			updated.Color, err = validateColor(*input.Color)
+			if err != nil {
+				return err
+			}
 		}
 
-		commits[c.Hash] = c
+		delete(game.playerNames, player.DisplayName)
+		game.playerNames[updated.DisplayName] = struct{}{}
+		game.players[player.ID] = &updated
+		game.NotifyPlayersChanged(model.PlayerEventTypeUpdated, &updated)
 		return nil
 	})
-	if err != nil && err != baseCommitReached {
-		return nil, fmt.Errorf("failed to iterate through commits from target branch: %w", err)
+	if err != nil {
+		return nil, err
 	}
 
-	// Sort commit based on commit time
-	sortedCommits := make([]*object.Commit, 0, len(commits))
-	for _, commit := range commits {
-		sortedCommits = append(sortedCommits, commit)
-	}
-	sort.SliceStable(sortedCommits, func(i, j int) bool {
-		a := sortedCommits[i]
-		b := sortedCommits[j]
-		return a.Author.When.Before(b.Author.When)
-	})
+	return &model.UpdatePlayerPayload{Player: &updated}, nil
+}
 
-	board, err := ReadBoard(base)
+func (r *mutationResolver) Leave(ctx context.Context, gameID string) (*model.LeavePayload, error) {
+	game, err := r.getGame(gameID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get base board: %w", err)
+		return nil, err
 	}
 
-	// For each commits, apply the change
-	for _, commit := range sortedCommits {
-		board, err = ApplyCommit(board, commit)
+	var player *model.Player
+	err = game.do(func() error {
+		player, err = game.getPlayer(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Nobody could manage the branches of the player anymore, release them first
+		err = game.releaseBranches(player.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to apply commit %s: %w", commit.Hash.String(), err)
+			return err
 		}
+		delete(game.playerNames, player.DisplayName)
+		delete(game.players, player.ID)
+		game.RemovePresence(player.ID)
+		game.NotifyPlayersChanged(model.PlayerEventTypeLeft, player)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Commit that new board as the merge commit
-	wt, err := r.repo.Worktree()
+	return &model.LeavePayload{Player: player}, nil
+}
+
+func (r *mutationResolver) SetCursor(ctx context.Context, input model.SetCursorInput) (*model.SetCursorPayload, error) {
+	if input.Row < 0 || input.Row >= 9 || input.Col < 0 || input.Col >= 9 {
+		return nil, gqlerrors.ErrInvalidInputCoordinate()
+	}
+
+	game, err := r.getGame(input.GameID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get the worktree: %w", err)
+		return nil, err
 	}
-	err = wt.Checkout(&git.CheckoutOptions{
-		Branch: sourceRef.Name(),
-		Force:  true,
-	})
+
+	_, err = game.repo.Reference(plumbing.NewBranchReferenceName(input.BranchID), false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to checkout the source commit: %w", err)
+		return nil, gqlerrors.ErrBranchNotFound(input.BranchID)
 	}
-	_, err = r.CommitBoard(wt, board, fmt.Sprintf("MERGE %s %s", input.SourceBranchID, input.TargetBranchID), player)
+
+	var presence *model.Presence
+	err = game.do(func() error {
+		player, err := game.getPlayer(ctx)
+		if err != nil {
+			return err
+		}
+
+		presence = game.SetCursor(player, input.BranchID, input.Row, input.Col)
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create a merge commit: %w", err)
+		return nil, err
 	}
 
-	// Not support other form of merging yet :(
-	return &model.MergeBranchPayload{SourceBranch: ConvertBranch(sourceRef)}, nil
+	return &model.SetCursorPayload{Presence: presence}, nil
 }
 
-func (r *mutationResolver) Join(ctx context.Context) (*model.JoinPayload, error) {
-	// Get the IP address from the request
-	ip, err := middleware.ForContext(ctx)
+func (r *mutationResolver) SendMessage(ctx context.Context, input model.SendMessageInput) (*model.SendMessagePayload, error) {
+	game, err := r.getGame(input.GameID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get the user's IP")
+		return nil, err
 	}
 
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	var branchID string
+	if input.BranchID != nil {
+		branchID = *input.BranchID
+		_, err = game.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+		if err != nil {
+			return nil, gqlerrors.ErrBranchNotFound(branchID)
+		}
+	}
 
-	// Return the player if already used
-	player, exist := r.players[ip]
-	if exist {
-		return &model.JoinPayload{Player: player}, nil
+	var message *model.Message
+	err = game.do(func() error {
+		player, err := game.getPlayer(ctx)
+		if err != nil {
+			return err
+		}
+
+		message, err = game.AddMessage(player, branchID, input.Text)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Otherwise add a new player
-	newPlayerName := namesgenerator.GetUniqueRandomName(r.playerNames)
-	newPlayer := &model.Player{
-		ID:          uuid.NewString(),
-		DisplayName: newPlayerName,
+	return &model.SendMessagePayload{Message: message}, nil
+}
+
+func (r *queryResolver) Games(ctx context.Context) ([]*model.Game, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*model.Game, 0, len(r.games))
+	for gameID := range r.games {
+		result = append(result, &model.Game{ID: gameID})
 	}
-	r.playerNames[newPlayerName] = struct{}{}
-	r.players[ip] = newPlayer
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
 
-	return &model.JoinPayload{Player: newPlayer}, nil
+	return result, nil
 }
 
-func (r *queryResolver) Sudoku(ctx context.Context) (*model.Sudoku, error) {
-	return r.sudoku, nil
+func (r *queryResolver) Game(ctx context.Context, id string) (*model.Game, error) {
+	game, err := r.getGame(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Game{ID: game.ID}, nil
 }
 
-func (r *queryResolver) Branch(ctx context.Context, id string) (*model.Branch, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+func (r *queryResolver) Sudoku(ctx context.Context, gameID string) (*model.Sudoku, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.sudoku, nil
+}
 
-	ref, err := r.repo.Reference(plumbing.NewBranchReferenceName(id), false)
+func (r *queryResolver) Branch(ctx context.Context, gameID string, id string) (*model.Branch, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := game.repo.Reference(plumbing.NewBranchReferenceName(id), false)
 	if err != nil {
 		return nil, gqlerrors.ErrBranchNotFound(id)
 	}
 	zap.L().Info("Reference(resolved:false)", zap.Any("ref", ref))
 
-	return ConvertBranch(ref), nil
+	return game.ConvertBranch(ref), nil
 }
 
-func (r *queryResolver) Branches(ctx context.Context) ([]*model.Branch, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+func (r *queryResolver) Branches(ctx context.Context, gameID string) ([]*model.Branch, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
 
-	refs, err := r.repo.Branches()
+	refs, err := game.repo.Branches()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read branches: %w", err)
 	}
 
 	branches := make([]*model.Branch, 0)
 	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		branches = append(branches, ConvertBranch(ref))
+		branches = append(branches, game.ConvertBranch(ref))
 		return nil
 	})
 	if err != nil {
@@ -450,17 +918,19 @@ func (r *queryResolver) Branches(ctx context.Context) ([]*model.Branch, error) {
 	return branches, nil
 }
 
-func (r *queryResolver) Commit(ctx context.Context, id string) (*model.Commit, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+func (r *queryResolver) Commit(ctx context.Context, gameID string, id string) (*model.Commit, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
 
-	commit, err := r.repo.CommitObject(plumbing.NewHash(id))
+	commit, err := game.repo.CommitObject(plumbing.NewHash(id))
 	if err != nil {
-		r.Error("Failed to get commit.", zap.Error(err))
+		game.Error("Failed to get commit.", zap.Error(err))
 		return nil, gqlerrors.ErrCommitNotFound(id)
 	}
 
-	result, err := ConvertCommit(commit)
+	result, err := game.ConvertCommit(commit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert commit: %w", err)
 	}
@@ -468,48 +938,228 @@ func (r *queryResolver) Commit(ctx context.Context, id string) (*model.Commit, e
 	return result, nil
 }
 
-func (r *queryResolver) Players(ctx context.Context) ([]*model.Player, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+func (r *queryResolver) Players(ctx context.Context, gameID string) ([]*model.Player, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.snapshot().playerList, nil
+}
 
-	result := make([]*model.Player, 0, len(r.players))
-	for _, player := range r.players {
-		result = append(result, player)
+func (r *queryResolver) Messages(ctx context.Context, gameID string, branchID *string, limit *int) ([]*model.Message, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	n := defaultMessagesLimit
+	if limit != nil {
+		n = *limit
+	}
+	if n <= 0 || n > maxMessages {
+		n = maxMessages
+	}
+
+	return game.Messages(stringValue(branchID), n), nil
+}
+
+func (r *queryResolver) MergePreview(ctx context.Context, gameID string, sourceBranchID string, targetBranchID string, strategy *model.MergeStrategy) (*model.MergePreview, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.PreviewMerge(sourceBranchID, targetBranchID, mergeStrategy(strategy))
+}
+
+func (r *queryResolver) Proposals(ctx context.Context, gameID string, status *model.ProposalStatus) ([]*model.Proposal, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.Proposals(status), nil
+}
+
+func (r *queryResolver) Proposal(ctx context.Context, gameID string, id string) (*model.Proposal, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.Proposal(id)
+}
+
+func (r *subscriptionResolver) CommitAdded(ctx context.Context, gameID string, branchID string, sinceCommitID *string) (<-chan *model.Commit, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	var missed *replay
+	if sinceCommitID != nil {
+		missed = game.commitsReplay(branchID, *sinceCommitID)
+	}
+
+	commits := make(chan *model.Commit)
+	err = game.subscribe(ctx, commitsTopic(branchID), missed, func(event interface{}, done <-chan struct{}) {
+		select {
+		case commits <- event.(*model.Commit):
+		case <-done:
+		}
+	}, func() {
+		close(commits)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+func (r *subscriptionResolver) PlayersChanged(ctx context.Context, gameID string) (<-chan *model.PlayersChangedEvent, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *model.PlayersChangedEvent)
+	err = game.subscribe(ctx, playersTopic, nil, func(event interface{}, done <-chan struct{}) {
+		select {
+		case events <- event.(*model.PlayersChangedEvent):
+		case <-done:
+		}
+	}, func() {
+		close(events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
 }
 
-func (r *subscriptionResolver) CommitAdded(ctx context.Context, branchID string) (<-chan *model.Commit, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+func (r *subscriptionResolver) Presence(ctx context.Context, gameID string, branchID string) (<-chan *model.Presence, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
 
-	commitsChan, cleanUp, err := r.AddBranchObserver(branchID, uuid.NewString())
+	presences := make(chan *model.Presence)
+	err = game.subscribe(ctx, presenceTopic(branchID), game.presenceReplay(branchID), func(event interface{}, done <-chan struct{}) {
+		select {
+		case presences <- event.(*model.Presence):
+		case <-done:
+		}
+	}, func() {
+		close(presences)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to add observer: %w", err)
+		return nil, err
 	}
 
-	// Add a defered clean up
+	// Keep the subscriber present for as long as its websocket is open, anonymous subscribers only watch
+	player, err := game.getPlayer(ctx)
+	if err != nil {
+		return presences, nil
+	}
+	err = game.do(func() error {
+		game.ConnectPresence(player.ID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	go func() {
 		<-ctx.Done()
-		r.mu.Lock()
-		defer r.mu.Unlock()
-		cleanUp()
+		game.do(func() error {
+			game.DisconnectPresence(player.ID)
+			return nil
+		})
 	}()
 
-	return commitsChan, nil
+	return presences, nil
+}
+
+func (r *subscriptionResolver) MessageAdded(ctx context.Context, gameID string, branchID *string) (<-chan *model.Message, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make(chan *model.Message)
+	err = game.subscribe(ctx, messagesTopic(stringValue(branchID)), nil, func(event interface{}, done <-chan struct{}) {
+		select {
+		case messages <- event.(*model.Message):
+		case <-done:
+		}
+	}, func() {
+		close(messages)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *subscriptionResolver) BranchEvents(ctx context.Context, gameID string) (<-chan *model.BranchEvent, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *model.BranchEvent)
+	err = game.subscribe(ctx, branchEventsTopic, nil, func(event interface{}, done <-chan struct{}) {
+		select {
+		case events <- event.(*model.BranchEvent):
+		case <-done:
+		}
+	}, func() {
+		close(events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *subscriptionResolver) ProposalEvents(ctx context.Context, gameID string) (<-chan *model.ProposalEvent, error) {
+	game, err := r.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *model.ProposalEvent)
+	err = game.subscribe(ctx, proposalsTopic, nil, func(event interface{}, done <-chan struct{}) {
+		select {
+		case events <- event.(*model.ProposalEvent):
+		case <-done:
+		}
+	}, func() {
+		close(events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
 }
 
 func (r *sudokuResolver) Branch(ctx context.Context, obj *model.Sudoku) (*model.Branch, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	game, err := r.getGame(obj.GameID)
+	if err != nil {
+		return nil, err
+	}
 
-	ref, err := r.repo.Reference(plumbing.NewBranchReferenceName(obj.BranchID), false)
+	ref, err := game.repo.Reference(plumbing.NewBranchReferenceName(obj.BranchID), false)
 	if err != nil {
 		return nil, gqlerrors.ErrBranchNotFound(obj.BranchID)
 	}
 
-	return ConvertBranch(ref), nil
+	return game.ConvertBranch(ref), nil
 }
 
 // Branch returns generated.BranchResolver implementation.
@@ -518,6 +1168,9 @@ func (r *Resolver) Branch() generated.BranchResolver { return &branchResolver{r}
 // Commit returns generated.CommitResolver implementation.
 func (r *Resolver) Commit() generated.CommitResolver { return &commitResolver{r} }
 
+// Game returns generated.GameResolver implementation.
+func (r *Resolver) Game() generated.GameResolver { return &gameResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -532,6 +1185,7 @@ func (r *Resolver) Sudoku() generated.SudokuResolver { return &sudokuResolver{r}
 
 type branchResolver struct{ *Resolver }
 type commitResolver struct{ *Resolver }
+type gameResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
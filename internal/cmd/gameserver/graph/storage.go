@@ -0,0 +1,131 @@
+package graph
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// Storage creates and opens the git repositories backing the games.
+type Storage interface {
+	// Init creates a new repository for the game.
+	Init(gameID string) (*git.Repository, error)
+
+	// Open opens the existing repository of the game, or returns git.ErrRepositoryNotExists.
+	Open(gameID string) (*git.Repository, error)
+
+	// List returns the IDs of every game kept in the storage.
+	List() ([]string, error)
+
+	// Remove deletes the repository of the game, like one left half-created.
+	Remove(gameID string) error
+}
+
+// MemoryStorage keeps every repository in memory, they are lost when the process exits. A closed game is reopened
+// from its repository for as long as the storage lives.
+type MemoryStorage struct {
+	mu    sync.Mutex
+	repos map[string]*git.Repository
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		repos: make(map[string]*git.Repository),
+	}
+}
+
+func (s *MemoryStorage) Init(gameID string) (*git.Repository, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.repos[gameID]; ok {
+		return nil, git.ErrRepositoryAlreadyExists
+	}
+	repo, err := git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		return nil, err
+	}
+	s.repos[gameID] = repo
+
+	return repo, nil
+}
+
+func (s *MemoryStorage) Open(gameID string) (*git.Repository, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	repo, ok := s.repos[gameID]
+	if !ok {
+		return nil, git.ErrRepositoryNotExists
+	}
+
+	return repo, nil
+}
+
+func (s *MemoryStorage) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	gameIDs := make([]string, 0, len(s.repos))
+	for gameID := range s.repos {
+		gameIDs = append(gameIDs, gameID)
+	}
+	sort.Strings(gameIDs)
+
+	return gameIDs, nil
+}
+
+func (s *MemoryStorage) Remove(gameID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.repos, gameID)
+
+	return nil
+}
+
+// FilesystemStorage keeps every game as a bare git repository in its own folder under Root.
+type FilesystemStorage struct {
+	Root string
+}
+
+func (s FilesystemStorage) Init(gameID string) (*git.Repository, error) {
+	return git.PlainInit(s.path(gameID), true)
+}
+
+func (s FilesystemStorage) Open(gameID string) (*git.Repository, error) {
+	return git.PlainOpen(s.path(gameID))
+}
+
+func (s FilesystemStorage) List() ([]string, error) {
+	files, err := ioutil.ReadDir(s.Root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the data folder: %w", err)
+	}
+
+	gameIDs := make([]string, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() {
+			gameIDs = append(gameIDs, file.Name())
+		}
+	}
+
+	return gameIDs, nil
+}
+
+func (s FilesystemStorage) Remove(gameID string) error {
+	return os.RemoveAll(s.path(gameID))
+}
+
+func (s FilesystemStorage) path(gameID string) string {
+	return filepath.Join(s.Root, gameID)
+}
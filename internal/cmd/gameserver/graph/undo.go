@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// Trailers of the commit messages of undo and redo commits, followed by the commit undone or redone
+const (
+	undoTrailer = "Undo: "
+	redoTrailer = "Redo: "
+)
+
+// Undo commits the inverse of the most recent commit of the player on the branch that isn't undone yet. Only the
+// commits of the player on the first parent history of the branch are undone, and only if the cell is still as the
+// commit left it, so that the work of the other players is never undone.
+func (g *Game) Undo(branchID string, player *model.Player) (*object.Commit, error) {
+	target, err := g.findUndo(branchID, player, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.commitInverse(branchID, target, undoTrailer, player)
+}
+
+// Redo undoes the most recent undo commit of the player on the branch, as long as the player made no other commit
+// since.
+func (g *Game) Redo(branchID string, player *model.Player) (*object.Commit, error) {
+	target, err := g.findUndo(branchID, player, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.commitInverse(branchID, target, redoTrailer, player)
+}
+
+func (g *Game) commitInverse(branchID string, target *object.Commit, trailer string, player *model.Player) (*object.Commit, error) {
+	c, err := g.readCellCommit(target.Hash.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return g.commitCellChange(branchID, c, c.after, fmt.Sprintf("%s\n\n%s%s", c.inverse(), trailer, target.Hash.String()), player)
+}
+
+// findUndo walks the commits of the player on the branch from the most recent one, like the undo and redo stacks of
+// the player. It returns the commit to undo, or the undo commit to redo.
+func (g *Game) findUndo(branchID string, player *model.Player, redo bool) (*object.Commit, error) {
+	ref, err := g.repo.Reference(plumbing.NewBranchReferenceName(branchID), false)
+	if err != nil {
+		return nil, gqlerrors.ErrBranchNotFound(branchID)
+	}
+	commit, err := g.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit %s: %w", ref.Hash().String(), err)
+	}
+
+	// undone are the commits undone by a later undo commit, redone the undo commits undone by a later redo commit
+	undone := make(map[string]struct{})
+	redone := make(map[string]struct{})
+	for ; ; commit, err = commit.Parent(0) {
+		if err == object.ErrParentNotFound {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the history of branch %s: %w", branchID, err)
+		}
+		if commit.Author.Email != player.ID {
+			continue
+		}
+		switch model.CommitType(strings.SplitN(commitSummary(commit.Message), " ", 2)[0]) {
+		case model.CommitTypeAddFill, model.CommitTypeRemoveFill, model.CommitTypeToggleNote:
+		default:
+			continue
+		}
+
+		if undoneID, ok := messageTrailer(commit.Message, undoTrailer); ok {
+			undone[undoneID] = struct{}{}
+			if _, ok := redone[commit.Hash.String()]; redo && !ok {
+				return commit, nil
+			}
+			continue
+		}
+		if redoneID, ok := messageTrailer(commit.Message, redoTrailer); ok {
+			redone[redoneID] = struct{}{}
+			if redo {
+				continue
+			}
+		}
+		if _, ok := undone[commit.Hash.String()]; ok {
+			continue
+		}
+
+		// The most recent change of the player which isn't undone
+		if redo {
+			break
+		}
+		return commit, nil
+	}
+
+	if redo {
+		return nil, gqlerrors.ErrNothingToRedo(branchID)
+	}
+	return nil, gqlerrors.ErrNothingToUndo(branchID)
+}
+
+// messageTrailer returns the value of the trailer of the commit message.
+func messageTrailer(message, trailer string) (string, bool) {
+	for _, line := range strings.Split(message, "\n")[1:] {
+		if strings.HasPrefix(line, trailer) {
+			return strings.TrimPrefix(line, trailer), true
+		}
+	}
+
+	return "", false
+}
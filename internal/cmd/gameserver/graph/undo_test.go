@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/gqlerrors"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+)
+
+// undoStep is a commit of a player on the branch, or an undo or a redo of the player.
+type undoStep struct {
+	player *model.Player
+	change BoardChange
+	undo   bool
+	redo   bool
+}
+
+func TestGame_UndoRedo(t *testing.T) {
+	commitStep := func(player *model.Player, change BoardChange) undoStep {
+		return undoStep{player: player, change: change}
+	}
+	undo := undoStep{player: alice, undo: true}
+	redo := undoStep{player: alice, redo: true}
+
+	tests := []struct {
+		name  string
+		steps []undoStep
+		// expected are the values of (0, 0) and (0, 2) after the last step
+		expected [2]int
+		err      error
+		conflict bool
+	}{
+		{
+			name:     "UndoMostRecentFirst",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), commitStep(alice, fill(0, 2, 6)), undo},
+			expected: [2]int{4, 0},
+		},
+		{
+			name:     "UndoEverything",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), commitStep(alice, fill(0, 2, 6)), undo, undo},
+			expected: [2]int{0, 0},
+		},
+		{
+			name:     "NothingToUndo",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), undo, undo},
+			expected: [2]int{0, 0},
+			err:      gqlerrors.ErrNothingToUndo("alice"),
+		},
+		{
+			name:     "RedoMostRecentUndoFirst",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), commitStep(alice, fill(0, 2, 6)), undo, undo, redo},
+			expected: [2]int{4, 0},
+		},
+		{
+			name:     "RedoEverything",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), commitStep(alice, fill(0, 2, 6)), undo, undo, redo, redo},
+			expected: [2]int{4, 6},
+		},
+		{
+			name:     "NothingToRedo",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), undo, redo, redo},
+			expected: [2]int{4, 0},
+			err:      gqlerrors.ErrNothingToRedo("alice"),
+		},
+		{
+			name:     "RedoAfterNewCommit",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), undo, commitStep(alice, fill(0, 2, 6)), redo},
+			expected: [2]int{0, 6},
+			err:      gqlerrors.ErrNothingToRedo("alice"),
+		},
+		{
+			name:     "UndoRedo",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), undo, redo, undo},
+			expected: [2]int{0, 0},
+		},
+		{
+			name:     "SkipOtherPlayers",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), commitStep(bob, fill(0, 2, 6)), undo},
+			expected: [2]int{0, 6},
+		},
+		{
+			name:     "CellChangedByOtherPlayer",
+			steps:    []undoStep{commitStep(alice, fill(0, 0, 4)), commitStep(bob, fill(0, 0, 7)), undo},
+			expected: [2]int{7, 0},
+			conflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			game := newTestGame(t)
+			addBranch(t, game, "alice", masterBranch)
+			last := len(tt.steps) - 1
+			for _, step := range tt.steps[:last] {
+				var err error
+				switch {
+				case step.undo:
+					_, err = game.Undo("alice", step.player)
+				case step.redo:
+					_, err = game.Redo("alice", step.player)
+				default:
+					_, err = game.CommitChange("alice", step.change, step.player)
+				}
+				r.NoError(err, "step")
+			}
+
+			// Act
+			step := tt.steps[last]
+			var err error
+			if step.redo {
+				_, err = game.Redo("alice", step.player)
+			} else {
+				_, err = game.Undo("alice", step.player)
+			}
+
+			// Assert
+			var conflict *cellConflictError
+			switch {
+			case tt.conflict:
+				r.True(errors.As(err, &conflict), "conflict")
+			case tt.err != nil:
+				r.Equal(tt.err, err, "err")
+			default:
+				r.NoError(err, "err")
+			}
+			board := tipBoard(t, game, "alice")
+			r.Equal(tt.expected, [2]int{board[0][0].Value, board[0][2].Value}, "board")
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package merge
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+// ConflictType tells how both branches changed a cell differently.
+type ConflictType string
+
+const (
+	// ConflictValue is a cell filled with different values
+	ConflictValue ConflictType = "VALUE"
+
+	// ConflictFillErase is a cell filled on a branch and erased on the other
+	ConflictFillErase ConflictType = "FILL_ERASE"
+
+	// ConflictNotes is a cell with different notes
+	ConflictNotes ConflictType = "NOTES"
+)
+
+// Change is a commit of a branch since the merge base.
+type Change struct {
+	When time.Time
+
+	// Apply applies the change to the board, it may change the board in place
+	Apply func(board engine.Board) (engine.Board, error)
+}
+
+// Branch is one of the branches merged.
+type Branch struct {
+	// Board is the board at the tip of the branch
+	Board engine.Board
+
+	// Changes are the commits of the branch since the merge base, in any order
+	Changes []Change
+}
+
+// Input is what a strategy merges, the source branch is the one the merge commit goes on.
+type Input struct {
+	Base   engine.Board
+	Source Branch
+	Target Branch
+}
+
+// Conflict is a cell left to resolve by the players.
+type Conflict struct {
+	Row  int
+	Col  int
+	Type ConflictType
+}
+
+// Result is the merged board, the cells in conflict hold the cell of the source branch until resolved.
+type Result struct {
+	Board     engine.Board
+	Conflicts []Conflict
+}
+
+// Strategy merges the target branch into the source branch. Strategies never change their input.
+type Strategy interface {
+	Merge(in Input) (*Result, error)
+}
+
+var (
+	_ Strategy = Replay{}
+	_ Strategy = ThreeWay{}
+)
+
+// Replay applies the changes of both branches on the merge base chronologically. The cells both branches changed
+// differently are in conflict, the last change would win silently otherwise.
+type Replay struct{}
+
+func (Replay) Merge(in Input) (*Result, error) {
+	changes := make([]Change, 0, len(in.Source.Changes)+len(in.Target.Changes))
+	changes = append(changes, in.Source.Changes...)
+	changes = append(changes, in.Target.Changes...)
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].When.Before(changes[j].When)
+	})
+
+	board := in.Base.Copy()
+	var err error
+	for _, change := range changes {
+		board, err = change.Apply(board)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay change: %w", err)
+		}
+	}
+
+	conflicts := FindConflicts(in.Base, in.Source.Board, in.Target.Board)
+	for _, conflict := range conflicts {
+		board[conflict.Row][conflict.Col] = copyCell(in.Source.Board[conflict.Row][conflict.Col])
+	}
+
+	return &Result{Board: board, Conflicts: conflicts}, nil
+}
+
+// Preference picks a side of the conflicts of a three-way merge.
+type Preference int
+
+const (
+	// PreferNone leaves the conflicts to resolve
+	PreferNone Preference = iota
+
+	// PreferSource resolves the conflicts with the source branch, like the ours strategy of git
+	PreferSource
+
+	// PreferTarget resolves the conflicts with the target branch, like the theirs strategy of git
+	PreferTarget
+)
+
+// ThreeWay compares the boards of both branches with the merge base, the value and the notes of each cell separately.
+// A branch changing them wins over a branch leaving them as is, the ordering of the commits doesn't matter.
+type ThreeWay struct {
+	Prefer Preference
+}
+
+func (s ThreeWay) Merge(in Input) (*Result, error) {
+	board := in.Base.Copy()
+	conflicts := make([]Conflict, 0)
+	for row := range board {
+		for col := range board[row] {
+			base, source, target := in.Base[row][col], in.Source.Board[row][col], in.Target.Board[row][col]
+
+			cell := &board[row][col]
+			cell.Value = mergeValue(base.Value, source.Value, target.Value)
+			if !notesEqual(source.Notes, base.Notes) {
+				cell.Notes = append(engine.Notes(nil), source.Notes...)
+			} else {
+				cell.Notes = append(engine.Notes(nil), target.Notes...)
+			}
+
+			conflictType, ok := findConflict(base, source, target)
+			if !ok {
+				continue
+			}
+			switch s.Prefer {
+			case PreferSource:
+				*cell = copyCell(source)
+			case PreferTarget:
+				*cell = copyCell(target)
+			default:
+				*cell = copyCell(source)
+				conflicts = append(conflicts, Conflict{Row: row, Col: col, Type: conflictType})
+			}
+		}
+	}
+
+	return &Result{Board: board, Conflicts: conflicts}, nil
+}
+
+// FindConflicts lists the cells both branches changed differently since the merge base.
+func FindConflicts(base, source, target engine.Board) []Conflict {
+	conflicts := make([]Conflict, 0)
+	for row := range base {
+		for col := range base[row] {
+			conflictType, ok := findConflict(base[row][col], source[row][col], target[row][col])
+			if ok {
+				conflicts = append(conflicts, Conflict{Row: row, Col: col, Type: conflictType})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// findConflict returns how the cell conflicts when both branches changed it from the base differently.
+func findConflict(base, source, target engine.Cell) (ConflictType, bool) {
+	if source.Value != base.Value && target.Value != base.Value && source.Value != target.Value {
+		if source.Value == 0 || target.Value == 0 {
+			return ConflictFillErase, true
+		}
+		return ConflictValue, true
+	}
+
+	if !notesEqual(source.Notes, base.Notes) && !notesEqual(target.Notes, base.Notes) && !notesEqual(source.Notes, target.Notes) {
+		return ConflictNotes, true
+	}
+
+	return "", false
+}
+
+func mergeValue(base, source, target int) int {
+	if source != base {
+		return source
+	}
+
+	return target
+}
+
+// CellEqual tells whether both cells have the same value and notes.
+func CellEqual(a, b engine.Cell) bool {
+	return a.Value == b.Value && notesEqual(a.Notes, b.Notes)
+}
+
+func notesEqual(a, b engine.Notes) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func copyCell(cell engine.Cell) engine.Cell {
+	cell.Notes = append(engine.Notes(nil), cell.Notes...)
+	return cell
+}
@@ -0,0 +1,146 @@
+package merge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+func emptyBoard() engine.Board {
+	board := make(engine.Board, 9)
+	for row := range board {
+		board[row] = make([]engine.Cell, 9)
+		for col := range board[row] {
+			board[row][col].Notes = make(engine.Notes, 9)
+		}
+	}
+
+	return board
+}
+
+// fill returns the change filling the cell, and applies it to the board of the branch
+func fill(board engine.Board, when time.Time, row, col, val int) Change {
+	board[row][col].Value = val
+	return Change{
+		When: when,
+		Apply: func(board engine.Board) (engine.Board, error) {
+			board[row][col].Value = val
+			return board, nil
+		},
+	}
+}
+
+func TestReplay_SameCellDifferentValues_ReturnConflict(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	now := time.Now()
+	base, source, target := emptyBoard(), emptyBoard(), emptyBoard()
+	in := Input{
+		Base: base,
+		Source: Branch{Board: source, Changes: []Change{
+			fill(source, now, 0, 0, 4),
+			fill(source, now.Add(2*time.Second), 1, 1, 7),
+		}},
+		Target: Branch{Board: target, Changes: []Change{
+			fill(target, now.Add(time.Second), 0, 0, 5),
+		}},
+	}
+
+	// Act
+	result, err := Replay{}.Merge(in)
+
+	// Assert
+	r.NoError(err, "Merge")
+	r.Equal([]Conflict{{Row: 0, Col: 0, Type: ConflictValue}}, result.Conflicts, "conflicts")
+	r.Equal(4, result.Board[0][0].Value, "conflict holds the source cell")
+	r.Equal(7, result.Board[1][1].Value, "replayed")
+	r.Equal(0, base[1][1].Value, "base unchanged")
+}
+
+func TestReplay_ChangesOutOfOrder_ApplyChronologically(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange, both branches end with the same value so there is no conflict
+	now := time.Now()
+	source, target := emptyBoard(), emptyBoard()
+	in := Input{
+		Base: emptyBoard(),
+		Source: Branch{Board: source, Changes: []Change{
+			fill(source, now.Add(2*time.Second), 0, 0, 3),
+		}},
+		Target: Branch{Board: target, Changes: []Change{
+			fill(target, now.Add(time.Second), 0, 0, 9),
+			fill(target, now.Add(3*time.Second), 0, 0, 3),
+		}},
+	}
+
+	// Act
+	result, err := Replay{}.Merge(in)
+
+	// Assert
+	r.NoError(err, "Merge")
+	r.Empty(result.Conflicts, "conflicts")
+	r.Equal(3, result.Board[0][0].Value, "value")
+}
+
+func TestThreeWay_ChangedOnOneSide_TakeChangedSide(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	base, source, target := emptyBoard(), emptyBoard(), emptyBoard()
+	base[0][0].Value, source[0][0].Value, target[0][0].Value = 1, 1, 0
+	source[1][1].Value = 5
+	target[2][2].Notes[3] = true
+
+	// Act
+	result, err := ThreeWay{}.Merge(Input{Base: base, Source: Branch{Board: source}, Target: Branch{Board: target}})
+
+	// Assert
+	r.NoError(err, "Merge")
+	r.Empty(result.Conflicts, "conflicts")
+	r.Equal(0, result.Board[0][0].Value, "erased on target")
+	r.Equal(5, result.Board[1][1].Value, "filled on source")
+	r.Equal([]int{4}, result.Board[2][2].Notes.AsNumbers(), "noted on target")
+}
+
+func TestThreeWay_FillAndErase_ReturnConflict(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	base, source, target := emptyBoard(), emptyBoard(), emptyBoard()
+	base[0][0].Value, source[0][0].Value, target[0][0].Value = 1, 2, 0
+	source[1][1].Notes[0] = true
+	target[1][1].Notes[1] = true
+
+	// Act
+	result, err := ThreeWay{}.Merge(Input{Base: base, Source: Branch{Board: source}, Target: Branch{Board: target}})
+
+	// Assert
+	r.NoError(err, "Merge")
+	r.Equal([]Conflict{
+		{Row: 0, Col: 0, Type: ConflictFillErase},
+		{Row: 1, Col: 1, Type: ConflictNotes},
+	}, result.Conflicts, "conflicts")
+}
+
+func TestThreeWay_PreferTarget_ResolveWithTarget(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	base, source, target := emptyBoard(), emptyBoard(), emptyBoard()
+	source[0][0].Value, target[0][0].Value = 4, 5
+	source[1][1].Value = 6
+
+	// Act
+	result, err := ThreeWay{Prefer: PreferTarget}.Merge(Input{Base: base, Source: Branch{Board: source}, Target: Branch{Board: target}})
+
+	// Assert
+	r.NoError(err, "Merge")
+	r.Empty(result.Conflicts, "conflicts")
+	r.Equal(5, result.Board[0][0].Value, "conflict")
+	r.Equal(6, result.Board[1][1].Value, "no conflict")
+}
@@ -3,17 +3,21 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 )
 
 type ipContextKey string
 
 var contextKey ipContextKey = "ip"
 
-func IPMiddleware() func(http.Handler) http.Handler {
+// IPMiddleware keeps the address of the client in the context. The X-Forwarded-For header is only honored
+// for requests coming from one of the trusted proxies.
+func IPMiddleware(trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ip := getIP(r)
+			ip := getIP(r, trustedProxies)
 			ctx := context.WithValue(r.Context(), contextKey, ip)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -28,11 +32,69 @@ func ForContext(ctx context.Context) (string, error) {
 	return "", fmt.Errorf("IP does not exist in the context")
 }
 
-func getIP(r *http.Request) string {
-	forwarded := r.Header.Get("X-Forwarded-For")
-	if forwarded != "" {
-		return forwarded
+// ParseTrustedProxies parses a list of IP addresses and CIDR ranges.
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	result := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%s'", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			result = append(result, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': %w", proxy, err)
+		}
+		result = append(result, ipNet)
+	}
+
+	return result, nil
+}
+
+func getIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ip = host
+	}
+	if !isTrusted(ip, trustedProxies) {
+		return ip
+	}
+
+	// Walk the forwarded addresses from the closest hop, the first untrusted one is the client
+	forwarded := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if hop == "" {
+			continue
+		}
+		ip = hop
+		if !isTrusted(hop, trustedProxies) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func isTrusted(address string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
 	}
 
-	return r.RemoteAddr
+	return false
 }
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name     string
+		proxies  []string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "Empty",
+			expected: []string{},
+		},
+		{
+			name:     "IPv4",
+			proxies:  []string{"10.0.0.1"},
+			expected: []string{"10.0.0.1/32"},
+		},
+		{
+			name:     "IPv6",
+			proxies:  []string{"::1"},
+			expected: []string{"::1/128"},
+		},
+		{
+			name:     "CIDR",
+			proxies:  []string{"10.0.0.0/8", "fd00::/8"},
+			expected: []string{"10.0.0.0/8", "fd00::/8"},
+		},
+		{
+			name:    "InvalidIP",
+			proxies: []string{"10.0.0"},
+			err:     true,
+		},
+		{
+			name:    "InvalidCIDR",
+			proxies: []string{"10.0.0.0/33"},
+			err:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			actual, err := ParseTrustedProxies(tt.proxies)
+
+			// Assert
+			if tt.err {
+				r.Error(err, "err")
+				return
+			}
+			r.NoError(err, "err")
+			networks := make([]string, 0, len(actual))
+			for _, network := range actual {
+				networks = append(networks, network.String())
+			}
+			r.Equal(tt.expected, networks, "networks")
+		})
+	}
+}
+
+func TestGetIP(t *testing.T) {
+	trustedProxies := []*net.IPNet{
+		{IP: net.ParseIP("10.0.0.0").To4(), Mask: net.CIDRMask(8, 32)},
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		expected   string
+	}{
+		{
+			name:       "Direct",
+			remoteAddr: "203.0.113.1:1234",
+			expected:   "203.0.113.1",
+		},
+		{
+			name:       "UntrustedForwarded",
+			remoteAddr: "203.0.113.1:1234",
+			forwarded:  "198.51.100.1",
+			expected:   "203.0.113.1",
+		},
+		{
+			name:       "TrustedForwarded",
+			remoteAddr: "10.0.0.1:1234",
+			forwarded:  "198.51.100.1",
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "SpoofedForwarded",
+			remoteAddr: "10.0.0.1:1234",
+			forwarded:  "192.0.2.1, 198.51.100.1",
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "TrustedChain",
+			remoteAddr: "10.0.0.1:1234",
+			forwarded:  "198.51.100.1, 10.0.0.2",
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "TrustedWithoutForwarded",
+			remoteAddr: "10.0.0.1:1234",
+			expected:   "10.0.0.1",
+		},
+		{
+			name:       "WithoutPort",
+			remoteAddr: "203.0.113.1",
+			expected:   "203.0.113.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+
+			// Act
+			actual := getIP(req, trustedProxies)
+
+			// Assert
+			r.Equal(tt.expected, actual, "ip")
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+type sessionContextKey string
+
+var sessionKey sessionContextKey = "session"
+
+// bearerPrefix prefixes the session token in the Authorization header and websocket init payload
+const bearerPrefix = "Bearer "
+
+// SessionMiddleware resolves the session token of the Authorization header, requests without a token are anonymous.
+func SessionMiddleware(signer *Signer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, err := withSession(r.Context(), signer, r.Header.Get("Authorization"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// WebsocketInitFunc resolves the session token of the connection_init payload, as its Authorization field.
+func WebsocketInitFunc(signer *Signer) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+		return withSession(ctx, signer, initPayload.Authorization())
+	}
+}
+
+func SessionForContext(ctx context.Context) (*Session, error) {
+	if session := ctx.Value(sessionKey); session != nil {
+		return session.(*Session), nil
+	}
+
+	return nil, fmt.Errorf("session does not exist in the context")
+}
+
+func withSession(ctx context.Context, signer *Signer, authorization string) (context.Context, error) {
+	if authorization == "" {
+		return ctx, nil
+	}
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, ErrInvalidToken
+	}
+
+	session, err := signer.Verify(strings.TrimPrefix(authorization, bearerPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	return context.WithValue(ctx, sessionKey, session), nil
+}
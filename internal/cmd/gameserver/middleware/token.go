@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid session token")
+	ErrExpiredToken = errors.New("expired session token")
+)
+
+const (
+	// tokenKeySize is the size of the signing key generated when none is configured
+	tokenKeySize = 32
+
+	// TokenMaxAge is how long a session token stays valid after it was issued, the player joins again afterwards
+	TokenMaxAge = 7 * 24 * time.Hour
+)
+
+// Session is the player a session token was issued to.
+type Session struct {
+	GameID   string    `json:"gid"`
+	PlayerID string    `json:"pid"`
+	IssuedAt time.Time `json:"iat"`
+}
+
+// Signer issues and verifies session tokens, a token is the session and its HMAC-SHA256 signature.
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+// NewRandomSigner creates a signer with a random key, its tokens are only valid for the lifetime of the process.
+func NewRandomSigner() (*Signer, error) {
+	key := make([]byte, tokenKeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token key: %w", err)
+	}
+
+	return NewSigner(key), nil
+}
+
+// Sign issues a token for the session.
+func (s *Signer) Sign(session Session) (string, error) {
+	payload, err := json.Marshal(session)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), nil
+}
+
+// Verify returns the session of a token issued by this signer less than TokenMaxAge ago.
+func (s *Signer) Verify(token string) (*Session, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, s.sign(parts[0])) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	var session Session
+	err = json.Unmarshal(payload, &session)
+	if err != nil || session.GameID == "" || session.PlayerID == "" {
+		return nil, ErrInvalidToken
+	}
+	if time.Since(session.IssuedAt) > TokenMaxAge {
+		return nil, ErrExpiredToken
+	}
+
+	return &session, nil
+}
+
+func (s *Signer) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	signer := NewSigner([]byte("key"))
+	sign := func(session Session) string {
+		token, err := signer.Sign(session)
+		require.NoError(t, err, "sign")
+		return token
+	}
+	session := Session{GameID: "game", PlayerID: "player", IssuedAt: time.Now().UTC()}
+	token := sign(session)
+	parts := strings.Split(token, ".")
+
+	tests := []struct {
+		name     string
+		signer   *Signer
+		token    string
+		expected *Session
+		err      error
+	}{
+		{
+			name:     "Valid",
+			signer:   signer,
+			token:    token,
+			expected: &session,
+		},
+		{
+			name:   "WrongKey",
+			signer: NewSigner([]byte("other key")),
+			token:  token,
+			err:    ErrInvalidToken,
+		},
+		{
+			name:   "TamperedPayload",
+			signer: signer,
+			token:  strings.Split(sign(Session{GameID: "game", PlayerID: "other", IssuedAt: session.IssuedAt}), ".")[0] + "." + parts[1],
+			err:    ErrInvalidToken,
+		},
+		{
+			name:   "TamperedSignature",
+			signer: signer,
+			token:  parts[0] + "." + parts[0],
+			err:    ErrInvalidToken,
+		},
+		{
+			name:   "Unsigned",
+			signer: signer,
+			token:  parts[0],
+			err:    ErrInvalidToken,
+		},
+		{
+			name:   "WithoutPlayer",
+			signer: signer,
+			token:  sign(Session{GameID: "game", IssuedAt: session.IssuedAt}),
+			err:    ErrInvalidToken,
+		},
+		{
+			name:   "Expired",
+			signer: signer,
+			token:  sign(Session{GameID: "game", PlayerID: "player", IssuedAt: time.Now().Add(-TokenMaxAge - time.Minute)}),
+			err:    ErrExpiredToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			actual, err := tt.signer.Verify(tt.token)
+
+			// Assert
+			r.Equal(tt.err, err, "err")
+			if tt.expected == nil {
+				r.Nil(actual, "session")
+				return
+			}
+			r.Equal(tt.expected.GameID, actual.GameID, "game")
+			r.Equal(tt.expected.PlayerID, actual.PlayerID, "player")
+			r.True(tt.expected.IssuedAt.Equal(actual.IssuedAt), "issued at")
+		})
+	}
+}
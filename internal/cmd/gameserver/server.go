@@ -6,11 +6,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/eventbus"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/generated"
 	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/middleware"
+	"github.com/nhan-ng/sudoku/internal/engine"
+	"github.com/nhan-ng/sudoku/internal/puzzle"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -27,17 +31,84 @@ var (
 
 type ServeOptions struct {
 	Port int
+
+	// DataDir keeps the game repositories on disk if set, otherwise they are kept in memory
+	DataDir string
+
+	// The puzzle of the default game, taken from the first set of Puzzle, PuzzleFile or PuzzleName in PuzzleDir,
+	// otherwise generated for Difficulty. Games created later can also pick their puzzle from PuzzleDir.
+	Puzzle     string
+	PuzzleFile string
+	PuzzleDir  string
+	PuzzleName string
+	Difficulty string
+
+	// TokenKey signs the session tokens of the players, a random key is used if not set
+	TokenKey string
+
+	// TrustedProxies are the IP addresses and CIDR ranges whose X-Forwarded-For header is honored
+	TrustedProxies []string
+
+	// EventQueueSize is the number of pending events of a subscriber, SlowConsumerPolicy what happens to
+	// the events past it. The event counters are exposed at /debug/vars.
+	EventQueueSize     int
+	SlowConsumerPolicy string
+
+	// MaxGames caps the number of games hosted, graph.DefaultMaxGames if not set
+	MaxGames int
 }
 
 func Serve(opts ServeOptions) error {
-	// Schema
-	resolver, err := graph.NewResolver()
-	if closer, ok := resolver.Resolvers.(io.Closer); ok {
-		defer closer.Close()
+	var storage graph.Storage = graph.NewMemoryStorage()
+	if opts.DataDir != "" {
+		zap.L().Info("Using filesystem storage.", zap.String("dataDir", opts.DataDir))
+		storage = graph.FilesystemStorage{Root: opts.DataDir}
+	}
+
+	p, err := loadPuzzle(opts)
+	if err != nil {
+		return fmt.Errorf("failed to load the puzzle: %w", err)
+	}
+	zap.L().Info("Loaded puzzle.", zap.String("name", p.Name), zap.String("source", string(p.Source)), zap.String("difficulty", string(p.Difficulty)))
+
+	trustedProxies, err := middleware.ParseTrustedProxies(opts.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
+	signer, err := newSigner(opts.TokenKey)
+	if err != nil {
+		return err
+	}
+
+	policy, err := eventbus.ParsePolicy(opts.SlowConsumerPolicy)
+	if err != nil {
+		return err
+	}
+
+	var library *puzzle.Library
+	if opts.PuzzleDir != "" {
+		library = &puzzle.Library{Dir: opts.PuzzleDir}
 	}
+
+	// Schema
+	resolver, err := graph.NewResolver(graph.ResolverOptions{
+		Storage: storage,
+		Puzzle:  p,
+		Library: library,
+		Signer:  signer,
+		Events: eventbus.Options{
+			QueueSize: opts.EventQueueSize,
+			Policy:    policy,
+		},
+		MaxGames: opts.MaxGames,
+	})
 	if err != nil {
 		return fmt.Errorf("failed to create a GraphQL resolver: %w", err)
 	}
+	if closer, ok := resolver.Resolvers.(io.Closer); ok {
+		defer closer.Close()
+	}
 
 	h := handler.New(generated.NewExecutableSchema(*resolver))
 	h.SetRecoverFunc(func(ctx context.Context, err interface{}) (userMessage error) {
@@ -54,6 +125,7 @@ func Serve(opts ServeOptions) error {
 	h.AddTransport(transport.POST{})
 	h.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              middleware.WebsocketInitFunc(signer),
 		Upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
 				return true
@@ -61,11 +133,51 @@ func Serve(opts ServeOptions) error {
 		},
 	})
 	h.Use(extension.Introspection{})
+	h.Use(graph.SubscriptionErrors{})
 
 	http.Handle("/", playground.Handler("Sudoku", "/graphql"))
-	http.Handle("/graphql", cors.AllowAll().Handler(middleware.IPMiddleware()(h)))
+	http.Handle("/graphql", cors.AllowAll().Handler(middleware.IPMiddleware(trustedProxies)(middleware.SessionMiddleware(signer)(h))))
 
 	port := opts.Port
 	zap.L().Info("Serving at localhost", zap.Int("port", port))
 	return http.ListenAndServe(fmt.Sprintf(":%d", port), nil)
 }
+
+func newSigner(key string) (*middleware.Signer, error) {
+	if key == "" {
+		zap.L().Warn("No token key is set, session tokens will be invalid after a restart.")
+		return middleware.NewRandomSigner()
+	}
+
+	return middleware.NewSigner([]byte(key)), nil
+}
+
+func loadPuzzle(opts ServeOptions) (*puzzle.Puzzle, error) {
+	switch {
+	case opts.Puzzle != "":
+		return puzzle.FromString(opts.Puzzle)
+
+	case opts.PuzzleFile != "":
+		return puzzle.FromFile(opts.PuzzleFile)
+
+	case opts.PuzzleName != "":
+		if opts.PuzzleDir == "" {
+			return nil, fmt.Errorf("a puzzle library is required for puzzle '%s'", opts.PuzzleName)
+		}
+		return puzzle.Library{Dir: opts.PuzzleDir}.Get(opts.PuzzleName)
+
+	default:
+		difficulty := engine.Difficulty(strings.ToUpper(opts.Difficulty))
+		if difficulty == "" {
+			difficulty = engine.DifficultyMedium
+		}
+		if !difficulty.IsValid() {
+			names := make([]string, 0, len(engine.AllDifficulties))
+			for _, d := range engine.AllDifficulties {
+				names = append(names, strings.ToLower(string(d)))
+			}
+			return nil, fmt.Errorf("unknown difficulty '%s', expected one of %s", opts.Difficulty, strings.Join(names, ", "))
+		}
+		return puzzle.Generate(difficulty)
+	}
+}
@@ -0,0 +1,124 @@
+package loadtest
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// bucketBounds are the upper bounds of the histogram buckets, doubling from 1ms up to ~32s
+var bucketBounds = func() []time.Duration {
+	bounds := make([]time.Duration, 0, 16)
+	for bound := time.Millisecond; bound <= 32*time.Second; bound *= 2 {
+		bounds = append(bounds, bound)
+	}
+	return bounds
+}()
+
+// Histogram is a fixed-bucket latency histogram safe for concurrent use.
+type Histogram struct {
+	mu sync.Mutex
+
+	// The last bucket counts everything above the largest bound
+	buckets []int64
+	count   int64
+	sum     time.Duration
+	min     time.Duration
+	max     time.Duration
+}
+
+func NewHistogram() *Histogram {
+	return &Histogram{
+		buckets: make([]int64, len(bucketBounds)+1),
+	}
+}
+
+func (h *Histogram) Record(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	i := 0
+	for i < len(bucketBounds) && d > bucketBounds[i] {
+		i++
+	}
+	h.buckets[i]++
+
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	if d > h.max {
+		h.max = d
+	}
+	h.count++
+	h.sum += d
+}
+
+func (h *Histogram) Count() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+func (h *Histogram) Mean() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.count == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.count)
+}
+
+func (h *Histogram) Max() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.max
+}
+
+// Percentile returns the upper bound of the bucket holding the p-th percentile, p in [0, 1].
+func (h *Histogram) Percentile(p float64) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.count == 0 {
+		return 0
+	}
+
+	// Nearest rank, the epsilon keeps float errors like 0.07*100 = 7.000000000000001 from rounding up
+	target := int64(math.Ceil(p*float64(h.count) - 1e-9))
+	if target < 1 {
+		target = 1
+	}
+	var cumulative int64
+	for i, count := range h.buckets {
+		cumulative += count
+		if cumulative >= target {
+			if i == len(bucketBounds) || h.max < bucketBounds[i] {
+				return h.max
+			}
+			return bucketBounds[i]
+		}
+	}
+
+	return h.max
+}
+
+// Buckets returns the non-empty buckets as pairs of upper bound and count. The bound of the overflow bucket is 0.
+func (h *Histogram) Buckets() ([]time.Duration, []int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	bounds := make([]time.Duration, 0, len(h.buckets))
+	counts := make([]int64, 0, len(h.buckets))
+	for i, count := range h.buckets {
+		if count == 0 {
+			continue
+		}
+		if i < len(bucketBounds) {
+			bounds = append(bounds, bucketBounds[i])
+		} else {
+			bounds = append(bounds, 0)
+		}
+		counts = append(counts, count)
+	}
+
+	return bounds, counts
+}
@@ -0,0 +1,157 @@
+package loadtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogram_Record_Buckets(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		bounds    []time.Duration
+		counts    []int64
+	}{
+		{
+			name:      "Empty",
+			durations: nil,
+			bounds:    []time.Duration{},
+			counts:    []int64{},
+		},
+		{
+			name:      "BoundIsInclusive",
+			durations: []time.Duration{500 * time.Microsecond, time.Millisecond, 1500 * time.Microsecond},
+			bounds:    []time.Duration{time.Millisecond, 2 * time.Millisecond},
+			counts:    []int64{2, 1},
+		},
+		{
+			name:      "Overflow",
+			durations: []time.Duration{3 * time.Millisecond, time.Minute},
+			bounds:    []time.Duration{4 * time.Millisecond, 0},
+			counts:    []int64{1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			h := NewHistogram()
+
+			// Act
+			for _, d := range tt.durations {
+				h.Record(d)
+			}
+
+			// Assert
+			bounds, counts := h.Buckets()
+			r.Equal(tt.bounds, bounds, "bounds")
+			r.Equal(tt.counts, counts, "counts")
+			r.Equal(int64(len(tt.durations)), h.Count(), "count")
+		})
+	}
+}
+
+func TestHistogram_MeanAndMax(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	h := NewHistogram()
+
+	// Act
+	for _, d := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 6 * time.Millisecond} {
+		h.Record(d)
+	}
+
+	// Assert
+	r.Equal(3*time.Millisecond, h.Mean(), "mean")
+	r.Equal(6*time.Millisecond, h.Max(), "max")
+	r.Zero(NewHistogram().Mean(), "empty mean")
+}
+
+func TestHistogram_Percentile(t *testing.T) {
+	// 1ms to 100ms, one each
+	uniform := make([]time.Duration, 0, 100)
+	for i := 1; i <= 100; i++ {
+		uniform = append(uniform, time.Duration(i)*time.Millisecond)
+	}
+
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		p         float64
+		want      time.Duration
+	}{
+		{
+			name: "Empty",
+			p:    0.5,
+			want: 0,
+		},
+		{
+			name:      "MinimumIsFirstBucket",
+			durations: uniform,
+			p:         0,
+			want:      time.Millisecond,
+		},
+		{
+			name:      "MedianIsUpperBoundOfBucket",
+			durations: uniform,
+			p:         0.5,
+			want:      64 * time.Millisecond,
+		},
+		{
+			name:      "LastBucketIsCappedByMax",
+			durations: uniform,
+			p:         0.99,
+			want:      100 * time.Millisecond,
+		},
+		{
+			name:      "NearestRankRoundsUp",
+			durations: append(repeat(0, 9), time.Second),
+			p:         0.95,
+			want:      time.Second,
+		},
+		{
+			// 0.07 * 100 is 7.000000000000001
+			name:      "FloatErrorDoesNotRoundUp",
+			durations: append(repeat(time.Millisecond, 7), repeat(time.Second, 93)...),
+			p:         0.07,
+			want:      time.Millisecond,
+		},
+		{
+			name:      "Overflow",
+			durations: []time.Duration{time.Millisecond, time.Minute},
+			p:         1,
+			want:      time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			h := NewHistogram()
+			for _, d := range tt.durations {
+				h.Record(d)
+			}
+
+			// Act
+			got := h.Percentile(tt.p)
+
+			// Assert
+			r.Equal(tt.want, got)
+		})
+	}
+}
+
+func repeat(d time.Duration, n int) []time.Duration {
+	result := make([]time.Duration, n)
+	for i := range result {
+		result[i] = d
+	}
+	return result
+}
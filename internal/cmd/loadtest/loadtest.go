@@ -0,0 +1,101 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/client"
+	"go.uber.org/zap"
+)
+
+type RunOptions struct {
+	Endpoint string
+	GameID   string
+
+	// Players is the number of simulated players, joining evenly over RampUp
+	Players int
+	RampUp  time.Duration
+
+	// Duration is how long the test runs after the ramp up
+	Duration time.Duration
+
+	// Rate is the number of operations per second of every player
+	Rate float64
+
+	// BranchRatio and MergeRatio are the share of operations creating branches and merging the main branch into them,
+	// the rest are commits
+	BranchRatio float64
+	MergeRatio  float64
+}
+
+func Run(opts RunOptions) error {
+	if opts.Players <= 0 {
+		return fmt.Errorf("invalid number of players %d", opts.Players)
+	}
+	if opts.Rate <= 0 {
+		return fmt.Errorf("invalid rate %f", opts.Rate)
+	}
+	if opts.BranchRatio < 0 || opts.MergeRatio < 0 || opts.BranchRatio+opts.MergeRatio > 1 {
+		return fmt.Errorf("invalid branch ratio %f and merge ratio %f", opts.BranchRatio, opts.MergeRatio)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.RampUp+opts.Duration)
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	// The fixed cells are shared by every player
+	sudoku, err := client.New(client.Options{Endpoint: opts.Endpoint, GameID: opts.GameID}).Sudoku(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the Sudoku: %w", err)
+	}
+
+	stats := NewStats()
+	start := time.Now()
+	zap.L().Info("Starting load test.", zap.Int("players", opts.Players), zap.Duration("duration", opts.Duration))
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Players; i++ {
+		p := &player{
+			index: i,
+			client: client.New(client.Options{
+				Endpoint: opts.Endpoint,
+				GameID:   opts.GameID,
+			}),
+			opts:   opts,
+			fixed:  sudoku.Board,
+			stats:  stats,
+			rand:   rand.New(rand.NewSource(time.Now().UnixNano() + int64(i))),
+			logger: zap.L().With(zap.Int("player", i)),
+			sent:   make(map[sentCommit]time.Time),
+		}
+		delay := time.Duration(int64(opts.RampUp) * int64(i) / int64(opts.Players))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			p.run(ctx)
+		}()
+	}
+	wg.Wait()
+
+	return stats.Report(os.Stdout, time.Since(start))
+}
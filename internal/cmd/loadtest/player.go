@@ -0,0 +1,222 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/client"
+	"github.com/nhan-ng/sudoku/internal/cmd/gameserver/graph/model"
+	"go.uber.org/zap"
+)
+
+// mainBranch is the branch every simulated player starts on and merges into its own branches
+const mainBranch = "master"
+
+// sentCommit identifies a commit of the player by its cell and value, until a subscription delivers it back
+type sentCommit struct {
+	row, col, val int
+}
+
+type player struct {
+	index  int
+	client *client.Client
+	opts   RunOptions
+	fixed  [][]int
+	stats  *Stats
+	rand   *rand.Rand
+	logger *zap.Logger
+
+	self         *client.Player
+	branchID     string
+	branches     int
+	branchCancel context.CancelFunc
+
+	// Send times of the commits of the player, read by the subscriptions to measure their delivery lag
+	mu   sync.Mutex
+	sent map[sentCommit]time.Time
+}
+
+func (p *player) run(ctx context.Context) {
+	err := p.stats.Time(operationJoin, func() error {
+		self, err := p.client.Join(ctx)
+		p.self = self
+		return err
+	})
+	if err != nil {
+		p.logger.Warn("Failed to join.", zap.Error(err))
+		return
+	}
+
+	// Every player watches the main branch, and its own branch once created
+	p.branchID = mainBranch
+	p.subscribe(ctx, mainBranch)
+	defer func() {
+		if p.branchCancel != nil {
+			p.branchCancel()
+		}
+	}()
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / p.opts.Rate))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var err error
+		r := p.rand.Float64()
+		switch {
+		case r < p.opts.BranchRatio:
+			err = p.addBranch(ctx)
+		case r < p.opts.BranchRatio+p.opts.MergeRatio && p.branchID != mainBranch:
+			err = p.mergeBranch(ctx)
+		default:
+			err = p.addCommit(ctx)
+		}
+		if err != nil && ctx.Err() == nil {
+			p.logger.Debug("Operation failed.", zap.Error(err))
+		}
+	}
+}
+
+func (p *player) subscribe(ctx context.Context, branchID string) {
+	var commits <-chan *client.Commit
+	var sub *client.Subscription
+	err := p.stats.Time(operationSubscribe, func() error {
+		var err error
+		sub, commits, err = p.client.CommitAdded(ctx, branchID, nil)
+		return err
+	})
+	if err != nil {
+		p.stats.RecordSubscriptionFailure()
+		p.logger.Warn("Failed to subscribe.", zap.String("branchId", branchID), zap.Error(err))
+		return
+	}
+
+	go func() {
+		for commit := range commits {
+			p.delivered(commit)
+		}
+		if sub.Err() != nil && ctx.Err() == nil {
+			p.stats.RecordSubscriptionFailure()
+			p.logger.Warn("Subscription failed.", zap.String("branchId", branchID), zap.Error(sub.Err()))
+		}
+	}()
+}
+
+func (p *player) addBranch(ctx context.Context) error {
+	p.branches++
+	branchID := fmt.Sprintf("loadtest-%d-%d", p.index, p.branches)
+	mainBranchID := mainBranch
+	err := p.stats.Time(operationAddBranch, func() error {
+		_, err := p.client.AddBranch(ctx, model.AddBranchInput{
+			ID:       branchID,
+			BranchID: &mainBranchID,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Move to the new branch
+	if p.branchCancel != nil {
+		p.branchCancel()
+	}
+	branchCtx, cancel := context.WithCancel(ctx)
+	p.branchCancel = cancel
+	p.branchID = branchID
+	p.subscribe(branchCtx, branchID)
+
+	return nil
+}
+
+// mergeBranch merges the main branch into the branch of the player. Merging the other way around takes an approved
+// proposal, master is only merged into through proposals.
+func (p *player) mergeBranch(ctx context.Context) error {
+	return p.stats.Time(operationMergeBranch, func() error {
+		_, err := p.client.MergeBranch(ctx, model.MergeBranchInput{
+			SourceBranchID: p.branchID,
+			TargetBranchID: mainBranch,
+			AuthorID:       p.self.ID,
+		})
+		return err
+	})
+}
+
+func (p *player) addCommit(ctx context.Context) error {
+	// Pick a random cell that isn't fixed
+	row, col := p.rand.Intn(9), p.rand.Intn(9)
+	for p.fixed[row][col] != 0 {
+		row, col = p.rand.Intn(9), p.rand.Intn(9)
+	}
+
+	input := model.AddCommitInput{
+		BranchID: p.branchID,
+		Row:      row,
+		Col:      col,
+	}
+	r := p.rand.Float64()
+	switch {
+	case r < 0.6:
+		input.Type = model.CommitTypeAddFill
+		input.Val = intPtr(p.rand.Intn(9) + 1)
+	case r < 0.8:
+		input.Type = model.CommitTypeRemoveFill
+	default:
+		input.Type = model.CommitTypeToggleNote
+		input.Val = intPtr(p.rand.Intn(9) + 1)
+	}
+
+	key := sentCommit{row: row, col: col}
+	if input.Val != nil {
+		key.val = *input.Val
+	}
+	p.mu.Lock()
+	// The first send of the same commit is kept until it is delivered
+	if _, ok := p.sent[key]; !ok {
+		p.sent[key] = time.Now()
+	}
+	p.mu.Unlock()
+
+	err := p.stats.Time(operationAddCommit, func() error {
+		_, err := p.client.AddCommit(ctx, input)
+		return err
+	})
+	if err != nil {
+		p.mu.Lock()
+		delete(p.sent, key)
+		p.mu.Unlock()
+	}
+
+	return err
+}
+
+// delivered records the delivery lag of a commit of the player, from the time the player sent it. The commits of
+// the other players are left out, as their send times are unknown and the server clock can't be compared with ours.
+func (p *player) delivered(commit *client.Commit) {
+	if commit.AuthorID != p.self.ID || commit.Row == nil || commit.Col == nil {
+		return
+	}
+	key := sentCommit{row: *commit.Row, col: *commit.Col}
+	if commit.Val != nil {
+		key.val = *commit.Val
+	}
+
+	p.mu.Lock()
+	sentAt, ok := p.sent[key]
+	delete(p.sent, key)
+	p.mu.Unlock()
+	if ok {
+		p.stats.RecordDelivery(sentAt)
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,157 @@
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	operationJoin        = "join"
+	operationSubscribe   = "subscribe"
+	operationAddCommit   = "addCommit"
+	operationAddBranch   = "addBranch"
+	operationMergeBranch = "mergeBranch"
+)
+
+type operationStats struct {
+	latency *Histogram
+	errors  int64
+}
+
+type Stats struct {
+	mu         sync.Mutex
+	operations map[string]*operationStats
+
+	// Delivery lag of subscription events, from the client sending a commit to the client receiving it back
+	deliveryLag          *Histogram
+	subscriptionFailures int64
+}
+
+func NewStats() *Stats {
+	return &Stats{
+		operations:  make(map[string]*operationStats),
+		deliveryLag: NewHistogram(),
+	}
+}
+
+// Time runs the operation and records its latency, or its error.
+func (s *Stats) Time(operation string, f func() error) error {
+	start := time.Now()
+	err := f()
+	elapsed := time.Since(start)
+
+	stats := s.operation(operation)
+	stats.latency.Record(elapsed)
+	if err != nil {
+		atomic.AddInt64(&stats.errors, 1)
+	}
+
+	return err
+}
+
+// RecordDelivery records the delivery lag of a commit sent by the client at sentAt.
+func (s *Stats) RecordDelivery(sentAt time.Time) {
+	s.deliveryLag.Record(time.Since(sentAt))
+}
+
+func (s *Stats) RecordSubscriptionFailure() {
+	atomic.AddInt64(&s.subscriptionFailures, 1)
+}
+
+func (s *Stats) operation(operation string) *operationStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.operations[operation]
+	if !ok {
+		stats = &operationStats{latency: NewHistogram()}
+		s.operations[operation] = stats
+	}
+
+	return stats
+}
+
+// Report writes a summary of the recorded latencies, errors and subscription delivery lag.
+func (s *Stats) Report(w io.Writer, elapsed time.Duration) error {
+	s.mu.Lock()
+	names := make([]string, 0, len(s.operations))
+	for name := range s.operations {
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "Elapsed: %s\n\n", elapsed.Round(time.Millisecond))
+	fmt.Fprintln(tw, "operation\tcount\terrors\terror rate\trate/s\tmean\tp50\tp90\tp99\tmax\t")
+	for _, name := range names {
+		stats := s.operation(name)
+		count := stats.latency.Count()
+		errors := atomic.LoadInt64(&stats.errors)
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.2f%%\t%.1f\t%s\t%s\t%s\t%s\t%s\t\n",
+			name, count, errors, percentage(errors, count), float64(count)/elapsed.Seconds(),
+			round(stats.latency.Mean()), round(stats.latency.Percentile(0.5)), round(stats.latency.Percentile(0.9)),
+			round(stats.latency.Percentile(0.99)), round(stats.latency.Max()))
+	}
+
+	lag := s.deliveryLag
+	fmt.Fprintf(tw, "\nsubscription\tdelivered\tfailures\t\t\tmean\tp50\tp90\tp99\tmax\t\n")
+	fmt.Fprintf(tw, "commitAdded\t%d\t%d\t\t\t%s\t%s\t%s\t%s\t%s\t\n",
+		lag.Count(), atomic.LoadInt64(&s.subscriptionFailures),
+		round(lag.Mean()), round(lag.Percentile(0.5)), round(lag.Percentile(0.9)), round(lag.Percentile(0.99)), round(lag.Max()))
+
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	// Latency histograms
+	for _, name := range names {
+		writeHistogram(w, name, s.operation(name).latency)
+	}
+	writeHistogram(w, "commitAdded delivery lag", lag)
+
+	return nil
+}
+
+func writeHistogram(w io.Writer, name string, h *Histogram) {
+	bounds, counts := h.Buckets()
+	total := h.Count()
+	if total == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%s:\n", name)
+	for i, bound := range bounds {
+		label := fmt.Sprintf("<= %s", bound)
+		if bound == 0 {
+			label = fmt.Sprintf("> %s", bucketBounds[len(bucketBounds)-1])
+		}
+		bar := int(50 * counts[i] / total)
+		fmt.Fprintf(w, "%12s %8d |%s\n", label, counts[i], bars(bar))
+	}
+}
+
+func bars(n int) string {
+	result := make([]byte, n)
+	for i := range result {
+		result[i] = '#'
+	}
+	return string(result)
+}
+
+func percentage(part, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(part) / float64(total)
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(100 * time.Microsecond)
+}
@@ -0,0 +1,65 @@
+package loadtest
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats_Time_RecordLatencyAndErrors(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	stats := NewStats()
+	failure := errors.New("failure")
+
+	// Act
+	err := stats.Time(operationAddCommit, func() error { return nil })
+	r.NoError(err, "success")
+	err = stats.Time(operationAddCommit, func() error { return failure })
+
+	// Assert
+	r.Equal(failure, err, "failure")
+	r.Equal(int64(2), stats.operation(operationAddCommit).latency.Count(), "count")
+	r.Equal(int64(1), stats.operation(operationAddCommit).errors, "errors")
+}
+
+func TestStats_RecordDelivery_LagSinceSent(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	stats := NewStats()
+
+	// Act
+	stats.RecordDelivery(time.Now().Add(-50 * time.Millisecond))
+
+	// Assert
+	r.Equal(int64(1), stats.deliveryLag.Count(), "count")
+	r.GreaterOrEqual(int64(stats.deliveryLag.Max()), int64(50*time.Millisecond), "lag")
+}
+
+func TestStats_Report(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	stats := NewStats()
+	_ = stats.Time(operationJoin, func() error { return nil })
+	_ = stats.Time(operationAddCommit, func() error { return errors.New("failure") })
+	stats.RecordSubscriptionFailure()
+	var buf bytes.Buffer
+
+	// Act
+	err := stats.Report(&buf, time.Second)
+
+	// Assert
+	r.NoError(err, "err")
+	report := buf.String()
+	r.Regexp(`join\s+1\s+0\s+0\.00%`, report, "join")
+	r.Regexp(`addCommit\s+1\s+1\s+100\.00%`, report, "addCommit")
+	r.Regexp(`commitAdded\s+0\s+1`, report, "commitAdded")
+	r.Contains(report, "\njoin:\n", "histogram")
+	r.NotContains(report, "delivery lag:", "empty histogram")
+}
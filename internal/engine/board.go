@@ -177,6 +177,22 @@ func (n Notes) AsNumbers() []int {
 	return result
 }
 
+// Copy returns a deep copy of the board, notes included.
+func (b Board) Copy() Board {
+	result := make(Board, len(b))
+	for i, row := range b {
+		result[i] = make([]Cell, len(row))
+		for j, cell := range row {
+			notes := make(Notes, len(cell.Notes))
+			copy(notes, cell.Notes)
+			cell.Notes = notes
+			result[i][j] = cell
+		}
+	}
+
+	return result
+}
+
 func (b Board) GetImmutableBoards() [][]int {
 	result := make([][]int, 9)
 	for i, row := range b {
@@ -0,0 +1,279 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+var (
+	ErrInvalidDifficulty = errors.New("sudoku: invalid difficulty")
+)
+
+type Difficulty string
+
+const (
+	// DifficultyEasy puzzles are solvable with naked singles only.
+	DifficultyEasy Difficulty = "EASY"
+	// DifficultyMedium puzzles need hidden singles.
+	DifficultyMedium Difficulty = "MEDIUM"
+	// DifficultyHard puzzles need naked pairs.
+	DifficultyHard Difficulty = "HARD"
+	// DifficultyExpert puzzles can't be solved with the known techniques alone.
+	DifficultyExpert Difficulty = "EXPERT"
+)
+
+var AllDifficulties = []Difficulty{
+	DifficultyEasy,
+	DifficultyMedium,
+	DifficultyHard,
+	DifficultyExpert,
+}
+
+// difficultyTechniques are the techniques a puzzle of each difficulty can be solved with
+var difficultyTechniques = map[Difficulty][]Technique{
+	DifficultyEasy:   {TechniqueNakedSingle},
+	DifficultyMedium: {TechniqueNakedSingle, TechniqueHiddenSingle},
+	DifficultyHard:   {TechniqueNakedSingle, TechniqueHiddenSingle, TechniqueNakedPair},
+}
+
+// difficultyGivens is the number of fixed cells the generator aims for on each difficulty
+var difficultyGivens = map[Difficulty]int{
+	DifficultyEasy:   36,
+	DifficultyMedium: 30,
+	DifficultyHard:   26,
+	DifficultyExpert: 23,
+}
+
+// generateAttempts is how many puzzles are generated before settling for an easier one than requested
+const generateAttempts = 20
+
+func (d Difficulty) IsValid() bool {
+	_, ok := difficultyGivens[d]
+	return ok
+}
+
+// Rate returns the difficulty of a puzzle by the techniques it takes to solve it.
+func Rate(board Board) Difficulty {
+	for _, difficulty := range AllDifficulties {
+		techniques, ok := difficultyTechniques[difficulty]
+		if !ok {
+			break
+		}
+		if solveLogically(board, techniques) {
+			return difficulty
+		}
+	}
+
+	return DifficultyExpert
+}
+
+// Generate creates a new puzzle with a unique solution for the given difficulty.
+func Generate(difficulty Difficulty, rnd *rand.Rand) (Board, error) {
+	if !difficulty.IsValid() {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDifficulty, difficulty)
+	}
+
+	var best Board
+	for attempt := 0; attempt < generateAttempts; attempt++ {
+		board := generate(difficulty, rnd)
+		rating := Rate(board)
+		if rating == difficulty {
+			return board, nil
+		}
+		if best == nil || difficultyRank(rating) > difficultyRank(Rate(best)) {
+			best = board
+		}
+	}
+
+	return best, nil
+}
+
+func generate(difficulty Difficulty, rnd *rand.Rand) Board {
+	var g grid
+	g.fill(rnd)
+
+	// Remove cells one by one as long as the solution stays unique and the puzzle not harder than requested
+	techniques := difficultyTechniques[difficulty]
+	givens := 81
+	for _, i := range rnd.Perm(81) {
+		if givens <= difficultyGivens[difficulty] {
+			break
+		}
+
+		val := g[i]
+		g[i] = 0
+		if g.countSolutions(2) != 1 || (techniques != nil && !solveLogically(g.board(), techniques)) {
+			g[i] = val
+			continue
+		}
+		givens--
+	}
+
+	return g.board()
+}
+
+// CountSolutions counts the solutions of the board, stopping at limit. A board with conflicting values has none.
+func CountSolutions(board Board, limit int) int {
+	var g grid
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			g[row*9+col] = board[row][col].Value
+		}
+	}
+
+	for i, val := range g {
+		if val == 0 {
+			continue
+		}
+		g[i] = 0
+		conflict := g.candidates(i)&(1<<val) == 0
+		g[i] = val
+		if conflict {
+			return 0
+		}
+	}
+
+	return g.countSolutions(limit)
+}
+
+func difficultyRank(d Difficulty) int {
+	for i, difficulty := range AllDifficulties {
+		if d == difficulty {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// solveLogically reports whether the board can be completed using only the given techniques.
+func solveLogically(board Board, techniques []Technique) bool {
+	board = board.Copy()
+	for {
+		step, err := FindStep(board, techniques...)
+		if err != nil {
+			break
+		}
+		board[step.Row][step.Col].Value = step.Value
+	}
+
+	for _, row := range board {
+		for _, cell := range row {
+			if cell.Value == 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// grid is a compact board used for generating puzzles, values are indexed by row*9+col.
+type grid [81]int
+
+func (g *grid) board() Board {
+	board := make(Board, 9)
+	for row := 0; row < 9; row++ {
+		board[row] = make([]Cell, 9)
+		for col := 0; col < 9; col++ {
+			val := g[row*9+col]
+			board[row][col] = Cell{
+				Immutable: val != 0,
+				Value:     val,
+				Notes:     make(Notes, 9),
+			}
+		}
+	}
+
+	return board
+}
+
+// candidates returns the bitmask of values that can be placed at cell i, bit val is set if val is possible.
+func (g *grid) candidates(i int) int {
+	row, col := i/9, i%9
+	used := 0
+	for j := 0; j < 9; j++ {
+		used |= 1 << g[row*9+j]
+		used |= 1 << g[j*9+col]
+	}
+	boxRow, boxCol := (row/3)*3, (col/3)*3
+	for r := boxRow; r < boxRow+3; r++ {
+		for c := boxCol; c < boxCol+3; c++ {
+			used |= 1 << g[r*9+c]
+		}
+	}
+
+	return ^used & 0x3FE
+}
+
+// fill completes the grid with a random valid solution.
+func (g *grid) fill(rnd *rand.Rand) bool {
+	i := g.mostConstrained()
+	if i < 0 {
+		return true
+	}
+
+	candidates := g.candidates(i)
+	for _, val := range rnd.Perm(9) {
+		val++
+		if candidates&(1<<val) == 0 {
+			continue
+		}
+		g[i] = val
+		if g.fill(rnd) {
+			return true
+		}
+	}
+	g[i] = 0
+
+	return false
+}
+
+// countSolutions counts the solutions of the grid, stopping at limit.
+func (g *grid) countSolutions(limit int) int {
+	i := g.mostConstrained()
+	if i < 0 {
+		return 1
+	}
+
+	count := 0
+	candidates := g.candidates(i)
+	for val := 1; val <= 9 && count < limit; val++ {
+		if candidates&(1<<val) == 0 {
+			continue
+		}
+		g[i] = val
+		count += g.countSolutions(limit - count)
+	}
+	g[i] = 0
+
+	return count
+}
+
+// mostConstrained returns the empty cell with the fewest candidates, or -1 if the grid is full.
+func (g *grid) mostConstrained() int {
+	result := -1
+	fewest := 10
+	for i := 0; i < 81; i++ {
+		if g[i] != 0 {
+			continue
+		}
+		count := bitCount(g.candidates(i))
+		if count < fewest {
+			result, fewest = i, count
+		}
+	}
+
+	return result
+}
+
+func bitCount(n int) int {
+	count := 0
+	for n != 0 {
+		n &= n - 1
+		count++
+	}
+
+	return count
+}
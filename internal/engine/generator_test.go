@@ -0,0 +1,91 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_EveryDifficulty_ReturnUniquelySolvablePuzzle(t *testing.T) {
+	for _, difficulty := range AllDifficulties {
+		t.Run(string(difficulty), func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			got, err := Generate(difficulty, rand.New(rand.NewSource(42)))
+
+			// Assert
+			r.NoError(err, "Generate")
+			givens := 0
+			for row := 0; row < 9; row++ {
+				for col := 0; col < 9; col++ {
+					if got[row][col].Value != 0 {
+						givens++
+						r.True(got[row][col].Immutable, "[%d][%d] Immutable", row, col)
+					}
+				}
+			}
+			r.Equal(1, CountSolutions(got, 2), "CountSolutions")
+			r.GreaterOrEqual(givens, difficultyGivens[difficulty], "givens")
+			r.LessOrEqual(difficultyRank(Rate(got)), difficultyRank(difficulty), "Rate")
+		})
+	}
+}
+
+func TestGenerate_InvalidDifficulty_ReturnError(t *testing.T) {
+	r := require.New(t)
+
+	// Act
+	_, err := Generate("IMPOSSIBLE", rand.New(rand.NewSource(42)))
+
+	// Assert
+	r.Error(err, "Generate")
+}
+
+func TestCountSolutions_ConflictingValues_ReturnZero(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	board, err := ReadBoard(`110000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000`)
+	r.NoError(err, "ReadBoard")
+
+	// Act
+	got := CountSolutions(board, 2)
+
+	// Assert
+	r.Equal(0, got, "CountSolutions")
+}
+
+func TestRate_SinglesOnlyPuzzle_ReturnEasy(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	// A solved board with a few cells removed only needs naked singles
+	board, err := ReadBoard(`534678912
+672195348
+198342567
+859761423
+426853791
+713924856
+961537284
+287419635
+345286170`)
+	r.NoError(err, "ReadBoard")
+	board[0][0].Value = 0
+	board[4][4].Value = 0
+
+	// Act
+	got := Rate(board)
+
+	// Assert
+	r.Equal(DifficultyEasy, got, "Rate")
+}
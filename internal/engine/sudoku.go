@@ -134,6 +134,11 @@ func (s *Sudoku) Solve() error {
 	return ErrCannotSolveBoard
 }
 
+// Solution returns a copy of the solved board.
+func (s *Sudoku) Solution() [][]int {
+	return duplicate(s.solvedBoard)
+}
+
 func (s *Sudoku) IsCompleted() bool {
 	return s.isCompleted(s.Board)
 }
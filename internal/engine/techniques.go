@@ -0,0 +1,209 @@
+package engine
+
+import (
+	"errors"
+)
+
+var (
+	ErrNoLogicalStep = errors.New("sudoku: no logical step found")
+)
+
+type Technique string
+
+const (
+	// TechniqueNakedSingle fills a cell that has only 1 candidate left.
+	TechniqueNakedSingle Technique = "NAKED_SINGLE"
+	// TechniqueHiddenSingle fills the only cell of a row, column or box that can hold a value.
+	TechniqueHiddenSingle Technique = "HIDDEN_SINGLE"
+	// TechniqueNakedPair removes the candidates of 2 cells sharing the same 2 candidates from the rest of their unit
+	// before looking for singles.
+	TechniqueNakedPair Technique = "NAKED_PAIR"
+)
+
+var AllTechniques = []Technique{
+	TechniqueNakedSingle,
+	TechniqueHiddenSingle,
+	TechniqueNakedPair,
+}
+
+// Step is a single logical deduction on a board. Row and Col are 0-based.
+type Step struct {
+	Row       int
+	Col       int
+	Value     int
+	Technique Technique
+}
+
+// Candidates returns the candidates of every cell of the board, candidates[row][col][val-1] is true if val can be
+// placed in the cell without breaking its row, column or box. Filled cells have no candidates.
+func Candidates(board Board) [][]Notes {
+	result := make([][]Notes, 9)
+	for row := 0; row < 9; row++ {
+		result[row] = make([]Notes, 9)
+		for col := 0; col < 9; col++ {
+			notes := make(Notes, 9)
+			result[row][col] = notes
+			if board[row][col].Value != 0 {
+				continue
+			}
+
+			for val := 1; val <= 9; val++ {
+				notes[val-1] = true
+			}
+			for _, peer := range peers(row, col) {
+				if val := board[peer[0]][peer[1]].Value; val != 0 {
+					notes[val-1] = false
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// FindStep finds the next logical step on the board using only the given techniques, or all of them if none is
+// given. It returns ErrNoLogicalStep if the techniques can't make any progress.
+func FindStep(board Board, techniques ...Technique) (*Step, error) {
+	if len(techniques) == 0 {
+		techniques = AllTechniques
+	}
+	allowed := make(map[Technique]bool, len(techniques))
+	for _, technique := range techniques {
+		allowed[technique] = true
+	}
+
+	candidates := Candidates(board)
+	if allowed[TechniqueNakedPair] {
+		eliminateNakedPairs(candidates)
+	}
+
+	if allowed[TechniqueNakedSingle] {
+		if step := findNakedSingle(candidates); step != nil {
+			return step, nil
+		}
+	}
+
+	if allowed[TechniqueHiddenSingle] {
+		if step := findHiddenSingle(board, candidates); step != nil {
+			return step, nil
+		}
+	}
+
+	return nil, ErrNoLogicalStep
+}
+
+func findNakedSingle(candidates [][]Notes) *Step {
+	for row := 0; row < 9; row++ {
+		for col := 0; col < 9; col++ {
+			numbers := candidates[row][col].AsNumbers()
+			if len(numbers) == 1 {
+				return &Step{
+					Row:       row,
+					Col:       col,
+					Value:     numbers[0],
+					Technique: TechniqueNakedSingle,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func findHiddenSingle(board Board, candidates [][]Notes) *Step {
+	for _, unit := range units() {
+		for val := 1; val <= 9; val++ {
+			var found [][2]int
+			placed := false
+			for _, cell := range unit {
+				if board[cell[0]][cell[1]].Value == val {
+					placed = true
+					break
+				}
+				if candidates[cell[0]][cell[1]][val-1] {
+					found = append(found, cell)
+				}
+			}
+
+			if !placed && len(found) == 1 {
+				return &Step{
+					Row:       found[0][0],
+					Col:       found[0][1],
+					Value:     val,
+					Technique: TechniqueHiddenSingle,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func eliminateNakedPairs(candidates [][]Notes) {
+	for _, unit := range units() {
+		for i, a := range unit {
+			pair := candidates[a[0]][a[1]].AsNumbers()
+			if len(pair) != 2 {
+				continue
+			}
+
+			for _, b := range unit[i+1:] {
+				other := candidates[b[0]][b[1]].AsNumbers()
+				if len(other) != 2 || other[0] != pair[0] || other[1] != pair[1] {
+					continue
+				}
+
+				// Found a pair, the values can't be anywhere else in the unit
+				for _, cell := range unit {
+					if cell == a || cell == b {
+						continue
+					}
+					candidates[cell[0]][cell[1]][pair[0]-1] = false
+					candidates[cell[0]][cell[1]][pair[1]-1] = false
+				}
+			}
+		}
+	}
+}
+
+// units returns every row, column and box as the list of their cells' coordinates.
+func units() [][][2]int {
+	result := make([][][2]int, 0, 27)
+	for i := 0; i < 9; i++ {
+		row := make([][2]int, 0, 9)
+		col := make([][2]int, 0, 9)
+		box := make([][2]int, 0, 9)
+		for j := 0; j < 9; j++ {
+			row = append(row, [2]int{i, j})
+			col = append(col, [2]int{j, i})
+			box = append(box, [2]int{(i/3)*3 + j/3, (i%3)*3 + j%3})
+		}
+		result = append(result, row, col, box)
+	}
+
+	return result
+}
+
+// peers returns the coordinates of every other cell sharing a row, column or box with the given cell.
+func peers(row, col int) [][2]int {
+	result := make([][2]int, 0, 20)
+	for i := 0; i < 9; i++ {
+		if i != col {
+			result = append(result, [2]int{row, i})
+		}
+		if i != row {
+			result = append(result, [2]int{i, col})
+		}
+	}
+
+	boxRow, boxCol := (row/3)*3, (col/3)*3
+	for i := boxRow; i < boxRow+3; i++ {
+		for j := boxCol; j < boxCol+3; j++ {
+			if i != row && j != col {
+				result = append(result, [2]int{i, j})
+			}
+		}
+	}
+
+	return result
+}
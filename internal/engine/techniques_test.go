@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const techniquesSudoku = `070308100
+040100000
+000090082
+001000500
+000000230
+000283070
+094005000
+526000700
+000000009`
+
+func TestFindStep_NakedSingle_ReturnExpected(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	board, err := ReadBoard(`123456780
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000`)
+	r.NoError(err, "ReadBoard")
+
+	// Act
+	got, err := FindStep(board, TechniqueNakedSingle)
+
+	// Assert
+	r.NoError(err, "FindStep")
+	r.Equal(&Step{Row: 0, Col: 8, Value: 9, Technique: TechniqueNakedSingle}, got, "got")
+}
+
+func TestFindStep_HiddenSingle_ReturnExpected(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	// 1 can only go to the top-left cell of the first box
+	board, err := ReadBoard(`000000000
+000100000
+000000100
+010000000
+000000000
+000000000
+001000000
+000000000
+000000000`)
+	r.NoError(err, "ReadBoard")
+
+	// Act
+	got, err := FindStep(board, TechniqueHiddenSingle)
+
+	// Assert
+	r.NoError(err, "FindStep")
+	r.Equal(&Step{Row: 0, Col: 0, Value: 1, Technique: TechniqueHiddenSingle}, got, "got")
+}
+
+func TestFindStep_EmptyBoard_ReturnErrNoLogicalStep(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	board, err := ReadBoard(`000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000
+000000000`)
+	r.NoError(err, "ReadBoard")
+
+	// Act
+	_, err = FindStep(board)
+
+	// Assert
+	r.Equal(ErrNoLogicalStep, err, "FindStep")
+}
+
+func TestFindStep_RepeatedUntilStuck_OnlyPlacesSolutionValues(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	board, err := ReadBoard(techniquesSudoku)
+	r.NoError(err, "ReadBoard")
+	sudoku, err := NewSudoku(board.GetImmutableBoards())
+	r.NoError(err, "NewSudoku")
+	solution := sudoku.Solution()
+
+	// Act & Assert
+	for {
+		step, err := FindStep(board)
+		if err == ErrNoLogicalStep {
+			break
+		}
+		r.NoError(err, "FindStep")
+		r.Equal(solution[step.Row][step.Col], step.Value, "step %+v", step)
+		board[step.Row][step.Col].Value = step.Value
+	}
+}
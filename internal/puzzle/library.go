@@ -0,0 +1,63 @@
+package puzzle
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrPuzzleNotFound = errors.New("puzzle: puzzle not found")
+)
+
+// libraryExt is the extension of the puzzle files in a library
+const libraryExt = ".txt"
+
+// Library is a folder of puzzle files, every entry is named after its file without the extension.
+type Library struct {
+	Dir string
+}
+
+// Names returns the names of every puzzle in the library, sorted.
+func (l Library) Names() ([]string, error) {
+	files, err := ioutil.ReadDir(l.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read puzzle library: %w", err)
+	}
+
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != libraryExt {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(file.Name(), libraryExt))
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Get reads the named puzzle from the library.
+func (l Library) Get(name string) (*Puzzle, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return nil, fmt.Errorf("%w: %s", ErrPuzzleNotFound, name)
+	}
+
+	// Folders are left out of the library like in Names
+	path := filepath.Join(l.Dir, name+libraryExt)
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) || err == nil && info.IsDir() {
+		return nil, fmt.Errorf("%w: %s", ErrPuzzleNotFound, name)
+	}
+	puzzle, err := FromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	puzzle.Source = SourceLibrary
+
+	return puzzle, nil
+}
@@ -0,0 +1,110 @@
+package puzzle
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLibrary returns a library with the monday and tuesday puzzles, next to a puzzle file outside of it.
+func newTestLibrary(t *testing.T) Library {
+	root := t.TempDir()
+	dir := filepath.Join(root, "library")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "folder"+libraryExt), 0o755), "library")
+	for _, path := range []string{
+		filepath.Join(dir, "tuesday"+libraryExt),
+		filepath.Join(dir, "monday"+libraryExt),
+		filepath.Join(dir, "notes.md"),
+		filepath.Join(root, "secret"+libraryExt),
+	} {
+		require.NoError(t, ioutil.WriteFile(path, []byte(testPuzzle), 0o644), "puzzle file %s", path)
+	}
+
+	return Library{Dir: dir}
+}
+
+func TestLibrary_Names_SortedPuzzleFilesOnly(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	library := newTestLibrary(t)
+
+	// Act
+	names, err := library.Names()
+
+	// Assert
+	r.NoError(err, "Names")
+	r.Equal([]string{"monday", "tuesday"}, names, "names")
+}
+
+func TestLibrary_Get(t *testing.T) {
+	tests := []struct {
+		name   string
+		puzzle string
+		found  bool
+	}{
+		{
+			name:   "Found",
+			puzzle: "monday",
+			found:  true,
+		},
+		{
+			name:   "Missing",
+			puzzle: "sunday",
+		},
+		{
+			name:   "Empty",
+			puzzle: "",
+		},
+		{
+			name:   "Dot",
+			puzzle: ".",
+		},
+		{
+			name:   "ParentFolder",
+			puzzle: "..",
+		},
+		{
+			name:   "OutsideLibrary",
+			puzzle: "../secret",
+		},
+		{
+			name:   "OutsideLibraryWithBackslash",
+			puzzle: `..\secret`,
+		},
+		{
+			name:   "Folder",
+			puzzle: "folder",
+		},
+		{
+			name:   "Subfolder",
+			puzzle: "folder/monday",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Arrange
+			library := newTestLibrary(t)
+
+			// Act
+			p, err := library.Get(tt.puzzle)
+
+			// Assert
+			if !tt.found {
+				r.True(errors.Is(err, ErrPuzzleNotFound), "not found: %v", err)
+				return
+			}
+			r.NoError(err, "Get")
+			r.Equal(tt.puzzle, p.Name, "name")
+			r.Equal(SourceLibrary, p.Source, "source")
+			r.Equal(25, p.Givens(), "givens")
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package puzzle
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nhan-ng/sudoku/internal/engine"
+)
+
+var (
+	ErrInvalidPuzzle = errors.New("puzzle: invalid puzzle")
+)
+
+type Source string
+
+const (
+	SourceString    Source = "STRING"
+	SourceFile      Source = "FILE"
+	SourceLibrary   Source = "LIBRARY"
+	SourceGenerated Source = "GENERATED"
+)
+
+// Puzzle is a Sudoku to start a game from, along with where it came from.
+type Puzzle struct {
+	Name       string
+	Source     Source
+	Difficulty engine.Difficulty
+	Board      engine.Board
+}
+
+// Givens returns the number of fixed cells of the puzzle.
+func (p *Puzzle) Givens() int {
+	givens := 0
+	for _, row := range p.Board {
+		for _, cell := range row {
+			if cell.Immutable {
+				givens++
+			}
+		}
+	}
+
+	return givens
+}
+
+// FromString reads a puzzle from 9 lines of 9 digits, or a single line of 81 digits. Empty cells are either 0 or '.'.
+func FromString(input string) (*Puzzle, error) {
+	return parse(input, "", SourceString)
+}
+
+// FromFile reads a puzzle from a file in the format of FromString.
+func FromFile(path string) (*Puzzle, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read puzzle file: %w", err)
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return parse(string(content), name, SourceFile)
+}
+
+// Generate creates a new puzzle of the given difficulty.
+func Generate(difficulty engine.Difficulty) (*Puzzle, error) {
+	seed := time.Now().UnixNano()
+	board, err := engine.Generate(difficulty, rand.New(rand.NewSource(seed)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate puzzle: %w", err)
+	}
+
+	return &Puzzle{
+		Name:       fmt.Sprintf("%s-%d", strings.ToLower(string(difficulty)), seed),
+		Source:     SourceGenerated,
+		Difficulty: engine.Rate(board),
+		Board:      board,
+	}, nil
+}
+
+func parse(input, name string, source Source) (*Puzzle, error) {
+	// Keep the digits only, so both the grid and the single line formats are accepted
+	digits := make([]byte, 0, 81)
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		for _, c := range line {
+			switch {
+			case c == '.':
+				digits = append(digits, '0')
+			case c >= '0' && c <= '9':
+				digits = append(digits, byte(c))
+			default:
+				return nil, fmt.Errorf("%w: unexpected character '%c'", ErrInvalidPuzzle, c)
+			}
+		}
+	}
+	if len(digits) != 81 {
+		return nil, fmt.Errorf("%w: expected 81 cells, got %d", ErrInvalidPuzzle, len(digits))
+	}
+
+	rows := make([]string, 0, 9)
+	for i := 0; i < 81; i += 9 {
+		rows = append(rows, string(digits[i:i+9]))
+	}
+	board, err := engine.ReadBoard(strings.Join(rows, "\n"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board: %w", err)
+	}
+
+	// Make sure the puzzle can be played to a single end
+	switch engine.CountSolutions(board, 2) {
+	case 0:
+		return nil, fmt.Errorf("%w: no solution", ErrInvalidPuzzle)
+	case 2:
+		return nil, fmt.Errorf("%w: more than 1 solution", ErrInvalidPuzzle)
+	}
+
+	return &Puzzle{
+		Name:       name,
+		Source:     source,
+		Difficulty: engine.Rate(board),
+		Board:      board,
+	}, nil
+}
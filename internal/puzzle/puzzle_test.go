@@ -0,0 +1,112 @@
+package puzzle
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPuzzle = `070308100
+040100000
+000090082
+001000500
+000000230
+000283070
+094005000
+526000700
+000000009`
+
+func TestFromString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "Grid",
+			input: testPuzzle,
+		},
+		{
+			name:  "GridWithBlankLines",
+			input: "\n  " + strings.ReplaceAll(testPuzzle, "\n", "\n\n") + "  \n",
+		},
+		{
+			name:  "SingleLine",
+			input: strings.ReplaceAll(testPuzzle, "\n", ""),
+		},
+		{
+			name:  "DotsForEmptyCells",
+			input: strings.ReplaceAll(testPuzzle, "0", "."),
+		},
+		{
+			name:  "UnexpectedCharacter",
+			input: strings.Replace(testPuzzle, "0", "x", 1),
+			err:   "puzzle: invalid puzzle: unexpected character 'x'",
+		},
+		{
+			name:  "MissingCells",
+			input: testPuzzle[:len(testPuzzle)-1],
+			err:   "puzzle: invalid puzzle: expected 81 cells, got 80",
+		},
+		{
+			name:  "NoSolution",
+			input: "11" + strings.ReplaceAll(testPuzzle, "\n", "")[2:],
+			err:   "puzzle: invalid puzzle: no solution",
+		},
+		{
+			name:  "ManySolutions",
+			input: strings.Repeat("0", 81),
+			err:   "puzzle: invalid puzzle: more than 1 solution",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			// Act
+			p, err := FromString(tt.input)
+
+			// Assert
+			if tt.err != "" {
+				r.True(errors.Is(err, ErrInvalidPuzzle), "invalid puzzle")
+				r.EqualError(err, tt.err, "err")
+				return
+			}
+			r.NoError(err, "FromString")
+			r.Equal(SourceString, p.Source, "source")
+			r.Empty(p.Name, "name")
+			r.Equal(25, p.Givens(), "givens")
+			r.Equal(7, p.Board[0][1].Value, "[0][1]")
+			r.True(p.Board[0][1].Immutable, "[0][1] immutable")
+			r.Zero(p.Board[0][0].Value, "[0][0]")
+			r.False(p.Board[0][0].Immutable, "[0][0] immutable")
+			r.NotEmpty(p.Difficulty, "difficulty")
+		})
+	}
+}
+
+func TestFromFile(t *testing.T) {
+	r := require.New(t)
+
+	// Arrange
+	dir := t.TempDir()
+	path := filepath.Join(dir, "monday.sdk")
+	r.NoError(ioutil.WriteFile(path, []byte(testPuzzle), 0o644), "puzzle file")
+
+	// Act
+	p, err := FromFile(path)
+	_, missingErr := FromFile(filepath.Join(dir, "missing.sdk"))
+
+	// Assert
+	r.NoError(err, "FromFile")
+	r.Equal("monday", p.Name, "name")
+	r.Equal(SourceFile, p.Source, "source")
+	r.Equal(25, p.Givens(), "givens")
+	r.True(errors.Is(missingErr, os.ErrNotExist), "missing file")
+}